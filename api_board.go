@@ -0,0 +1,222 @@
+package tapd
+
+import (
+	"context"
+	"net/http"
+)
+
+// BoardCardStatus 看板工作项状态
+type BoardCardStatus string
+
+const (
+	BoardCardStatusOpen BoardCardStatus = "open" // 未完成
+	BoardCardStatusDone BoardCardStatus = "done" // 已完成
+)
+
+// BoardCard 看板工作项
+type BoardCard struct {
+	ID          string          `json:"id,omitempty"`           // ID
+	WorkspaceID string          `json:"workspace_id,omitempty"` // 项目ID
+	BoardID     string          `json:"board_id,omitempty"`     // 看板ID
+	SectionID   string          `json:"section_id,omitempty"`   // 板块ID
+	Name        string          `json:"name,omitempty"`         // 标题
+	Description string          `json:"description,omitempty"`  // 详细描述
+	Status      BoardCardStatus `json:"status,omitempty"`       // 状态
+	Priority    string          `json:"priority,omitempty"`     // 优先级
+	Owner       string          `json:"owner,omitempty"`        // 处理人
+	Creator     string          `json:"creator,omitempty"`      // 创建人
+	Begin       string          `json:"begin,omitempty"`        // 预计开始
+	Due         string          `json:"due,omitempty"`          // 预计结束
+	Completed   string          `json:"completed,omitempty"`    // 完成时间
+	Created     string          `json:"created,omitempty"`      // 创建时间
+	Modified    string          `json:"modified,omitempty"`     // 最后修改时间
+}
+
+// BoardSection 看板板块
+type BoardSection struct {
+	ID          string `json:"id,omitempty"`           // 板块ID
+	WorkspaceID string `json:"workspace_id,omitempty"` // 项目ID
+	BoardID     string `json:"board_id,omitempty"`     // 看板ID
+	Name        string `json:"name,omitempty"`         // 板块名称
+	Sort        string `json:"sort,omitempty"`         // 排序
+	WipLimit    string `json:"wip_limit,omitempty"`    // 在制品数量限制
+	Creator     string `json:"creator,omitempty"`      // 创建人
+	Created     string `json:"created,omitempty"`      // 创建时间
+	Modified    string `json:"modified,omitempty"`     // 最后修改时间
+}
+
+// BoardService 看板服务
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/board/
+type BoardService struct {
+	client *Client
+}
+
+// -----------------------------------------------------------------------------
+// 新建看板工作项
+// -----------------------------------------------------------------------------
+
+type CreateBoardCardRequest struct {
+	WorkspaceID *int             `json:"workspace_id,omitempty"` // [必须]项目ID
+	BoardID     *int64           `json:"board_id,omitempty"`     // [必须]看板ID
+	Name        *string          `json:"name,omitempty"`         // [必须]标题
+	Creator     *string          `json:"creator,omitempty"`      // [必须]创建人
+	SectionID   *int64           `json:"section_id,omitempty"`   // 板块ID，不传时放入看板的第一个板块
+	Description *string          `json:"description,omitempty"`  // 详细描述
+	Status      *BoardCardStatus `json:"status,omitempty"`       // 状态
+	Priority    *string          `json:"priority,omitempty"`     // 优先级
+	Owner       *string          `json:"owner,omitempty"`        // 处理人
+	Begin       *string          `json:"begin,omitempty"`        // 预计开始
+	Due         *string          `json:"due,omitempty"`          // 预计结束
+}
+
+// CreateBoardCard 新建看板工作项
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/board/add_board_card.html
+func (s *BoardService) CreateBoardCard(
+	ctx context.Context, request *CreateBoardCardRequest, opts ...RequestOption,
+) (*BoardCard, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "board_cards", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var item struct {
+		BoardCard *BoardCard `json:"BoardCard"`
+	}
+	resp, err := s.client.Do(req, &item)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return item.BoardCard, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 获取看板工作项接口
+// -----------------------------------------------------------------------------
+
+type GetBoardCardsRequest struct {
+	WorkspaceID *int                   `url:"workspace_id,omitempty"` // [必须]项目ID
+	ID          *Multi[int64]          `url:"id,omitempty"`           // ID	支持多ID查询
+	BoardID     *int64                 `url:"board_id,omitempty"`     // 看板ID
+	SectionID   *Multi[int64]          `url:"section_id,omitempty"`   // 板块ID	支持多ID查询
+	Name        *string                `url:"name,omitempty"`         // 标题	支持模糊匹配
+	Status      *Enum[BoardCardStatus] `url:"status,omitempty"`       // 状态	支持枚举查询
+	Priority    *string                `url:"priority,omitempty"`     // 优先级
+	Owner       *string                `url:"owner,omitempty"`        // 处理人	支持模糊匹配
+	Creator     *string                `url:"creator,omitempty"`      // 创建人
+	Begin       *TimeQuery             `url:"begin,omitempty"`        // 预计开始	支持时间查询
+	Due         *TimeQuery             `url:"due,omitempty"`          // 预计结束	支持时间查询
+	Created     *TimeQuery             `url:"created,omitempty"`      // 创建时间	支持时间查询
+	Modified    *TimeQuery             `url:"modified,omitempty"`     // 最后修改时间	支持时间查询
+	Limit       *int                   `url:"limit,omitempty"`        // 设置返回数量限制，默认为30
+	Page        *int                   `url:"page,omitempty"`         // 返回当前数量限制下第N页的数据，默认为1（第一页）
+	Order       *Order                 `url:"order,omitempty"`        // 排序规则，规则：字段名 ASC或者DESC
+	Fields      *Multi[string]         `url:"fields,omitempty"`       // 设置获取的字段，多个字段间以','逗号隔开
+}
+
+// GetBoardCards 获取看板工作项接口
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/board/get_board_cards.html
+func (s *BoardService) GetBoardCards(
+	ctx context.Context, request *GetBoardCardsRequest, opts ...RequestOption,
+) ([]*BoardCard, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "board_cards", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var items []struct {
+		BoardCard *BoardCard `json:"BoardCard"`
+	}
+	resp, err := s.client.Do(req, &items)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	cards := make([]*BoardCard, 0, len(items))
+	for _, item := range items {
+		cards = append(cards, item.BoardCard)
+	}
+
+	return cards, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 更新看板工作项
+// -----------------------------------------------------------------------------
+
+type UpdateBoardCardRequest struct {
+	WorkspaceID *int             `json:"workspace_id,omitempty"` // [必须]项目ID
+	ID          *int64           `json:"id,omitempty"`           // [必须]ID
+	SectionID   *int64           `json:"section_id,omitempty"`   // 板块ID，修改后工作项移动到该板块
+	Name        *string          `json:"name,omitempty"`         // 标题
+	Description *string          `json:"description,omitempty"`  // 详细描述
+	Status      *BoardCardStatus `json:"status,omitempty"`       // 状态
+	Priority    *string          `json:"priority,omitempty"`     // 优先级
+	Owner       *string          `json:"owner,omitempty"`        // 处理人
+	Begin       *string          `json:"begin,omitempty"`        // 预计开始
+	Due         *string          `json:"due,omitempty"`          // 预计结束
+	CurrentUser *string          `json:"current_user,omitempty"` // 变更人
+}
+
+// UpdateBoardCard 更新看板工作项
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/board/update_board_card.html
+func (s *BoardService) UpdateBoardCard(
+	ctx context.Context, request *UpdateBoardCardRequest, opts ...RequestOption,
+) (*BoardCard, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "board_cards", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var item struct {
+		BoardCard *BoardCard `json:"BoardCard"`
+	}
+	resp, err := s.client.Do(req, &item)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return item.BoardCard, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 获取看板板块
+// -----------------------------------------------------------------------------
+
+type GetBoardSectionsRequest struct {
+	WorkspaceID *int          `url:"workspace_id,omitempty"` // [必须]项目ID
+	BoardID     *int64        `url:"board_id,omitempty"`     // [必须]看板ID
+	ID          *Multi[int64] `url:"id,omitempty"`           // 板块ID	支持多ID查询
+	Name        *string       `url:"name,omitempty"`         // 板块名称
+}
+
+// GetBoardSections 获取看板板块
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/board/get_board_sections.html
+func (s *BoardService) GetBoardSections(
+	ctx context.Context, request *GetBoardSectionsRequest, opts ...RequestOption,
+) ([]*BoardSection, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "board_cards/sections", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var items []struct {
+		BoardSection *BoardSection `json:"BoardSection"`
+	}
+	resp, err := s.client.Do(req, &items)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	sections := make([]*BoardSection, 0, len(items))
+	for _, item := range items {
+		sections = append(sections, item.BoardSection)
+	}
+
+	return sections, resp, nil
+}
@@ -0,0 +1,128 @@
+package tapd
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBoardService_CreateBoardCard(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/board_cards", r.URL.Path)
+
+		var req struct {
+			WorkspaceID int    `json:"workspace_id"`
+			BoardID     int64  `json:"board_id"`
+			Name        string `json:"name"`
+			Creator     string `json:"creator"`
+			Priority    string `json:"priority"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, 11112222, req.WorkspaceID)
+		assert.Equal(t, int64(1111112222001000131), req.BoardID)
+		assert.Equal(t, "[P1] 订单服务 5xx 错误率超过 5%", req.Name)
+		assert.Equal(t, "alertmanager", req.Creator)
+		assert.Equal(t, "high", req.Priority)
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/board/create_board_card.json"))
+	}))
+
+	card, _, err := client.BoardService.CreateBoardCard(ctx, &CreateBoardCardRequest{
+		WorkspaceID: Ptr(11112222),
+		BoardID:     Ptr[int64](1111112222001000131),
+		Name:        Ptr("[P1] 订单服务 5xx 错误率超过 5%"),
+		Creator:     Ptr("alertmanager"),
+		Priority:    Ptr("high"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "1111112222001000141", card.ID)
+	assert.Equal(t, "11112222", card.WorkspaceID)
+	assert.Equal(t, "1111112222001000131", card.BoardID)
+	assert.Equal(t, "1111112222001000151", card.SectionID)
+	assert.Equal(t, BoardCardStatusOpen, card.Status)
+	assert.Equal(t, "alertmanager", card.Creator)
+}
+
+func TestBoardService_GetBoardCards(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/board_cards", r.URL.Path)
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+		assert.Equal(t, "1111112222001000131", r.URL.Query().Get("board_id"))
+		assert.Equal(t, "open|done", r.URL.Query().Get("status"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/board/get_board_cards.json"))
+	}))
+
+	cards, _, err := client.BoardService.GetBoardCards(ctx, &GetBoardCardsRequest{
+		WorkspaceID: Ptr(11112222),
+		BoardID:     Ptr[int64](1111112222001000131),
+		Status:      NewEnum(BoardCardStatusOpen, BoardCardStatusDone),
+	})
+	require.NoError(t, err)
+	require.Len(t, cards, 2)
+	assert.Equal(t, "1111112222001000141", cards[0].ID)
+	assert.Equal(t, "张三", cards[0].Owner)
+	assert.Equal(t, "1111112222001000142", cards[1].ID)
+	assert.Equal(t, BoardCardStatusDone, cards[1].Status)
+	assert.Equal(t, "2025-02-28 18:00:00", cards[1].Completed)
+}
+
+func TestBoardService_UpdateBoardCard(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/board_cards", r.URL.Path)
+
+		var req struct {
+			WorkspaceID int    `json:"workspace_id"`
+			ID          int64  `json:"id"`
+			SectionID   int64  `json:"section_id"`
+			Owner       string `json:"owner"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, 11112222, req.WorkspaceID)
+		assert.Equal(t, int64(1111112222001000141), req.ID)
+		assert.Equal(t, int64(1111112222001000152), req.SectionID)
+		assert.Equal(t, "张三", req.Owner)
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/board/update_board_card.json"))
+	}))
+
+	card, _, err := client.BoardService.UpdateBoardCard(ctx, &UpdateBoardCardRequest{
+		WorkspaceID: Ptr(11112222),
+		ID:          Ptr[int64](1111112222001000141),
+		SectionID:   Ptr[int64](1111112222001000152),
+		Owner:       Ptr("张三"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "1111112222001000141", card.ID)
+	assert.Equal(t, "1111112222001000152", card.SectionID)
+	assert.Equal(t, "张三", card.Owner)
+}
+
+func TestBoardService_GetBoardSections(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/board_cards/sections", r.URL.Path)
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+		assert.Equal(t, "1111112222001000131", r.URL.Query().Get("board_id"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/board/get_board_sections.json"))
+	}))
+
+	sections, _, err := client.BoardService.GetBoardSections(ctx, &GetBoardSectionsRequest{
+		WorkspaceID: Ptr(11112222),
+		BoardID:     Ptr[int64](1111112222001000131),
+	})
+	require.NoError(t, err)
+	require.Len(t, sections, 3)
+	assert.Equal(t, "1111112222001000151", sections[0].ID)
+	assert.Equal(t, "待处理", sections[0].Name)
+	assert.Equal(t, "处理中", sections[1].Name)
+	assert.Equal(t, "5", sections[1].WipLimit)
+	assert.Equal(t, "已解决", sections[2].Name)
+}
@@ -38,6 +38,7 @@ func TestBugService_GetBugs(t *testing.T) {
 
 		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
 		assert.Equal(t, PriorityLabelHigh.String(), r.URL.Query().Get("priority_label"))
+		assert.Equal(t, "<>new|reopened", r.URL.Query().Get("status"))
 
 		_, _ = w.Write(loadData(t, "internal/testdata/api/bug/get_bugs.json"))
 	}))
@@ -45,6 +46,7 @@ func TestBugService_GetBugs(t *testing.T) {
 	bugs, _, err := client.BugService.GetBugs(ctx, &GetBugsRequest{
 		WorkspaceID:   Ptr(11112222),
 		PriorityLabel: Ptr(PriorityLabelHigh),
+		Status:        Not(NewEnum("new", "reopened")),
 	})
 	require.NoError(t, err)
 	require.True(t, len(bugs) > 0)
@@ -103,3 +105,309 @@ func TestBugService_UpdateBug(t *testing.T) {
 	assert.Equal(t, "", bug.Priority)
 	assert.Equal(t, BugSeverityNormal, bug.Severity)
 }
+
+func TestBugService_CreateBug(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/bugs", r.URL.Path)
+
+		var req struct {
+			WorkspaceID int         `json:"workspace_id"`
+			Title       string      `json:"title"`
+			Severity    BugSeverity `json:"severity"`
+			Reporter    string      `json:"reporter"`
+			Description string      `json:"description"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, 11112222, req.WorkspaceID)
+		assert.Equal(t, "崩溃：启动时空指针", req.Title)
+		assert.Equal(t, BugSeverityFatal, req.Severity)
+		assert.Equal(t, "triage-bot", req.Reporter)
+		assert.Equal(t, "<p>stack trace</p>", req.Description)
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/bug/create_bug.json"))
+	}))
+
+	bug, _, err := client.BugService.CreateBug(ctx, &CreateBugRequest{
+		WorkspaceID: Ptr(11112222),
+		Title:       Ptr("崩溃：启动时空指针"),
+		Severity:    Ptr(BugSeverityFatal),
+		Reporter:    Ptr("triage-bot"),
+		Description: Ptr("<p>stack trace</p>"),
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "11111222333001037100", bug.ID)
+	assert.Equal(t, "崩溃：启动时空指针", bug.Title)
+	assert.Equal(t, BugSeverityFatal, bug.Severity)
+	assert.Equal(t, "new", bug.Status)
+	assert.Equal(t, "triage-bot", bug.Reporter)
+	assert.Equal(t, "2025-01-02 10:00:00", bug.Created)
+}
+
+func TestBugService_CopyBug(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/bugs/copy_bug", r.URL.Path)
+
+		var req struct {
+			SrcBugID       int64 `json:"src_bug_id"`
+			SrcWorkspaceID int   `json:"src_workspace_id"`
+			DstWorkspaceID int   `json:"dst_workspace_id"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, int64(1111122233001037100), req.SrcBugID)
+		assert.Equal(t, 11112222, req.SrcWorkspaceID)
+		assert.Equal(t, 11113333, req.DstWorkspaceID)
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/bug/copy_bug.json"))
+	}))
+
+	bug, _, err := client.BugService.CopyBug(ctx, &CopyBugRequest{
+		SrcBugID:       Ptr[int64](1111122233001037100),
+		SrcWorkspaceID: Ptr(11112222),
+		DstWorkspaceID: Ptr(11113333),
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "11113333001037101", bug.ID)
+	assert.Equal(t, "11113333", bug.WorkspaceID)
+	assert.Equal(t, "崩溃：启动时空指针", bug.Title)
+}
+
+func TestBugService_GetBugChanges(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/bug_changes", r.URL.Path)
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+		assert.Equal(t, "1111122233001037077", r.URL.Query().Get("bug_id"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/bug/get_bug_changes.json"))
+	}))
+
+	changes, _, err := client.BugService.GetBugChanges(ctx, &GetBugChangesRequest{
+		WorkspaceID: Ptr(11112222),
+		BugID:       Ptr[int64](1111122233001037077),
+	})
+	require.NoError(t, err)
+	require.Len(t, changes, 3)
+
+	assert.Equal(t, "1111122233001129821", changes[0].ID)
+	assert.Equal(t, "11112222", changes[0].WorkspaceID)
+	assert.Equal(t, "11111222333001037077", changes[0].BugID)
+	assert.Equal(t, "张三", changes[0].Author)
+	assert.Equal(t, "status", changes[0].Field)
+	assert.Equal(t, "new", changes[0].OldValue)
+	assert.Equal(t, "in_progress", changes[0].NewValue)
+	assert.Equal(t, "2025-01-02 10:12:30", changes[0].Created)
+
+	// null values
+	assert.Equal(t, "current_owner", changes[1].Field)
+	assert.Equal(t, "", changes[1].OldValue)
+	assert.Equal(t, "李四;", changes[1].NewValue)
+	assert.Equal(t, "", changes[1].Memo)
+
+	// numeric values of custom fields
+	assert.Equal(t, "custom_field_one", changes[2].Field)
+	assert.Equal(t, "1", changes[2].OldValue)
+	assert.Equal(t, "2.5", changes[2].NewValue)
+}
+
+func TestBugService_GetBugChangesCount(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/bug_changes/count", r.URL.Path)
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/bug/get_bug_changes_count.json"))
+	}))
+
+	count, _, err := client.BugService.GetBugChangesCount(ctx, &GetBugChangesCountRequest{
+		WorkspaceID: Ptr(11112222),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 42, count)
+}
+
+func TestBugService_GetBugsCount(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/bugs/count", r.URL.Path)
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+		assert.Equal(t, "fatal|serious", r.URL.Query().Get("severity"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/bug/get_bugs_count.json"))
+	}))
+
+	count, _, err := client.BugService.GetBugsCount(ctx, &GetBugsCountRequest{
+		WorkspaceID: Ptr(11112222),
+		Severity:    NewEnum(BugSeverityFatal, BugSeveritySerious),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 268, count)
+}
+
+func TestBugService_GetBugCustomFieldsSettings(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/bugs/custom_fields_settings", r.URL.Path)
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/bug/get_bug_custom_fields_settings.json"))
+	}))
+
+	settings, _, err := client.BugService.GetBugCustomFieldsSettings(ctx, &GetBugCustomFieldsSettingsRequest{
+		WorkspaceID: Ptr(11112222),
+	})
+	require.NoError(t, err)
+	require.True(t, len(settings) > 0)
+	assert.Equal(t, "1111112222001000255", settings[0].ID)
+	assert.Equal(t, "bug", settings[0].EntryType)
+	assert.Equal(t, "custom_field_one", settings[0].CustomField)
+	assert.Equal(t, "select", settings[0].Type)
+	assert.Equal(t, "崩溃类型", settings[0].Name)
+	assert.Equal(t, Ptr("ANR|Native Crash|Java Crash"), settings[0].Options)
+	assert.Equal(t, "1", settings[0].Enabled)
+}
+
+func TestBugService_GetBugTemplates(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/bugs/template_list", r.URL.Path)
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/bug/get_bug_templates.json"))
+	}))
+
+	templates, _, err := client.BugService.GetBugTemplates(ctx, &GetBugTemplatesRequest{
+		WorkspaceID: Ptr(11112222),
+	})
+	require.NoError(t, err)
+	require.Len(t, templates, 2)
+	assert.Equal(t, "1111112222001000021", templates[0].ID)
+	assert.Equal(t, "缺陷默认模板", templates[0].Name)
+	assert.Equal(t, "系统自动创建", templates[0].Description)
+	assert.Equal(t, "SYSTEM", templates[0].Creator)
+	assert.Equal(t, "1", templates[0].EditorType)
+}
+
+func TestBugService_GetBugTemplateFields(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/bugs/get_default_bug_template", r.URL.Path)
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+		assert.Equal(t, "1111112222001000021", r.URL.Query().Get("template_id"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/bug/get_bug_template_fields.json"))
+	}))
+
+	fields, _, err := client.BugService.GetBugTemplateFields(ctx, &GetBugTemplateFieldsRequest{
+		WorkspaceID: Ptr(11112222),
+		TemplateID:  Ptr[int64](1111112222001000021),
+	})
+	require.NoError(t, err)
+	require.True(t, len(fields) > 0)
+	assert.Equal(t, "1111112222001000113", fields[0].ID)
+	assert.Equal(t, "bug", fields[0].Type)
+	assert.Equal(t, "1111112222001000021", fields[0].TemplateID)
+	assert.Equal(t, "title", fields[0].Field)
+	assert.Equal(t, "1", fields[0].Required)
+}
+
+func TestBugService_GetBugFieldsInfo(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/bugs/get_fields_info", r.URL.Path)
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/bug/get_bug_fields_info.json"))
+	}))
+
+	fields, _, err := client.BugService.GetBugFieldsInfo(ctx, &GetBugFieldsInfoRequest{
+		WorkspaceID: Ptr(11112222),
+	})
+	require.NoError(t, err)
+	require.Len(t, fields, 5)
+
+	var flag1, flag2 bool
+	for _, field := range fields {
+		if field.Name == "severity" {
+			assert.Equal(t, TaskFieldsInfoHTMLTypeSelect, field.HTMLType)
+			assert.Equal(t, "严重程度", field.Label)
+			assert.Contains(t, field.Options, FieldsInfoOption{Value: "fatal", Label: "致命"})
+			assert.Len(t, field.Options, 5)
+			flag1 = true
+		}
+		if field.Name == "custom_field_one" {
+			assert.Equal(t, TaskFieldsInfoHTMLTypeCascadeRadio, field.HTMLType)
+			require.Len(t, field.CascadeOptions, 1)
+			assert.Equal(t, "Android", field.CascadeOptions[0].Name)
+			assert.Len(t, field.CascadeOptions[0].Children, 2)
+			flag2 = true
+		}
+	}
+	assert.True(t, flag1)
+	assert.True(t, flag2)
+}
+
+func TestBugService_GetBugFieldsInfo_InvalidOptions(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(loadData(t, "internal/testdata/api/bug/get_bug_fields_info_invalid_options.json"))
+	}))
+
+	fields, _, err := client.BugService.GetBugFieldsInfo(ctx, &GetBugFieldsInfoRequest{
+		WorkspaceID: Ptr(11112222),
+	})
+	assert.ErrorContains(t, err, "severity")
+	assert.Nil(t, fields)
+}
+
+func TestBugService_GetRemovedBugs(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/bugs/get_removed_bugs", r.URL.Path)
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+		assert.Equal(t, "张三", r.URL.Query().Get("creator"))
+		assert.Equal(t, "10", r.URL.Query().Get("limit"))
+		assert.Equal(t, "1", r.URL.Query().Get("page"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/bug/get_removed_bugs.json"))
+	}))
+
+	bugs, _, err := client.BugService.GetRemovedBugs(ctx, &GetRemovedBugsRequest{
+		WorkspaceID: Ptr(11112222),
+		Creator:     Ptr("张三"),
+		Limit:       Ptr(10),
+		Page:        Ptr(1),
+	})
+	require.NoError(t, err)
+	require.Len(t, bugs, 2)
+	assert.Equal(t, "1111112222001035001", bugs[0].ID)
+	assert.Equal(t, "登录页白屏", bugs[0].Title)
+	assert.Equal(t, "张三", bugs[0].Creator)
+	assert.Equal(t, "2024-08-20 11:22:49", bugs[0].Created)
+	assert.Equal(t, "李四", bugs[0].OperationUser)
+	assert.Equal(t, "2024-08-21 09:00:00", bugs[0].Deleted)
+	assert.Equal(t, "0", bugs[0].IsArchived)
+}
+
+func TestBugService_GetBugRelatedStories(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/bugs/get_related_stories", r.URL.Path)
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+		assert.Equal(t, "1111112222001035927,1111112222001035984", r.URL.Query().Get("bug_id"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/bug/get_bug_related_stories.json"))
+	}))
+
+	stories, _, err := client.BugService.GetBugRelatedStories(ctx, &GetBugRelatedStoriesRequest{
+		WorkspaceID: Ptr(11112222),
+		BugID:       NewMulti[int64](1111112222001035927, 1111112222001035984),
+	})
+	require.NoError(t, err)
+	require.Len(t, stories, 2)
+	assert.Equal(t, 11112222, stories[0].WorkspaceID)
+	assert.Equal(t, "1111112222001035927", stories[0].BugID)
+	assert.Equal(t, "1111112222001063941", stories[0].StoryID)
+}
@@ -125,10 +125,10 @@ type GetCommentsRequest struct {
 	EntryID *int64 `url:"entry_id,omitempty"`
 
 	// 创建时间 支持时间查询
-	Created *string `url:"created,omitempty"`
+	Created *TimeQuery `url:"created,omitempty"`
 
 	// 最后更改时间 支持时间查询
-	Modified *string `url:"modified,omitempty"`
+	Modified *TimeQuery `url:"modified,omitempty"`
 
 	// 项目ID
 	WorkspaceID *int `url:"workspace_id,omitempty"`
@@ -192,10 +192,10 @@ type GetCommentsCountRequest struct {
 	EntryID *int64 `url:"entry_id,omitempty"`
 
 	// 创建时间 支持时间查询
-	Created *string `url:"created,omitempty"`
+	Created *TimeQuery `url:"created,omitempty"`
 
 	// 最后更改时间 支持时间查询
-	Modified *string `url:"modified,omitempty"`
+	Modified *TimeQuery `url:"modified,omitempty"`
 
 	// 项目ID
 	WorkspaceID *int `url:"workspace_id,omitempty"`
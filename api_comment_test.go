@@ -100,8 +100,8 @@ func TestCommentService_GetComments(t *testing.T) {
 		Author:      Ptr("author"),
 		EntryType:   Ptr(CommentEntryTypeStories),
 		EntryID:     Ptr[int64](123),
-		Created:     Ptr("created"),
-		Modified:    Ptr("modified"),
+		Created:     NewTimeQuery("created"),
+		Modified:    NewTimeQuery("modified"),
 		WorkspaceID: Ptr(111),
 		RootID:      Ptr[int64](222),
 		ReplyID:     Ptr[int64](333),
@@ -152,8 +152,8 @@ func TestCommentService_GetCommentsCount(t *testing.T) {
 		Author:      Ptr("test author"),
 		EntryType:   Ptr(CommentEntryTypeStories),
 		EntryID:     Ptr[int64](123),
-		Created:     Ptr("2024-08-28"),
-		Modified:    Ptr("2024-08-28"),
+		Created:     NewTimeQuery("2024-08-28"),
+		Modified:    NewTimeQuery("2024-08-28"),
 		WorkspaceID: Ptr(111),
 		RootID:      Ptr[int64](222),
 		ReplyID:     Ptr[int64](333),
@@ -82,6 +82,7 @@ type Iteration struct {
 	CustomField49  string `json:"custom_field_49,omitempty"`
 	CustomField50  string `json:"custom_field_50,omitempty"`
 	OriginName     string `json:"origin_name,omitempty"`
+	Locker         string `json:"locker,omitempty"`
 }
 
 // IterationService 迭代
@@ -176,7 +177,36 @@ type CreateIterationRequest struct {
 	CustomField50  *string       `json:"custom_field_50,omitempty"`  // 自定义字段参数
 }
 
-// 获取迭代自定义字段配置
+type GetIterationCustomFieldsSettingsRequest struct {
+	WorkspaceID *int `url:"workspace_id,omitempty"` // [必须]项目ID
+}
+
+// GetIterationCustomFieldsSettings 获取迭代自定义字段配置
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/iteration/get_iteration_custom_fields_settings.html
+func (s *IterationService) GetIterationCustomFieldsSettings(
+	ctx context.Context, request *GetIterationCustomFieldsSettingsRequest, opts ...RequestOption,
+) ([]*CustomFieldsSetting, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "iterations/custom_fields_settings", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var items []struct {
+		CustomFieldConfig *CustomFieldsSetting `json:"CustomFieldConfig"`
+	}
+	resp, err := s.client.Do(req, &items)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	settings := make([]*CustomFieldsSetting, 0, len(items))
+	for _, item := range items {
+		settings = append(settings, item.CustomFieldConfig)
+	}
+
+	return settings, resp, nil
+}
 
 // GetIterations 获取迭代
 //
@@ -210,14 +240,15 @@ type GetIterationsRequest struct {
 	Name           *string        `url:"name,omitempty"`             // 标题 支持模糊匹配
 	WorkspaceID    *int           `url:"workspace_id,omitempty"`     // 项目 ID
 	Description    *string        `url:"description,omitempty"`      // 详细描述
-	StartDate      *string        `url:"startdate,omitempty"`        // 开始时间 支持时间查询
-	EndDate        *string        `url:"enddate,omitempty"`          // 结束时间 支持时间查询
+	StartDate      *TimeQuery     `url:"startdate,omitempty"`        // 开始时间 支持时间查询
+	EndDate        *TimeQuery     `url:"enddate,omitempty"`          // 结束时间 支持时间查询
 	WorkitemTypeID *int           `url:"workitem_type_id,omitempty"` // 迭代类别
 	PlanAppID      *int           `url:"plan_app_id,omitempty"`      // 计划应用 ID
+	ReleaseID      *int           `url:"release_id,omitempty"`       // 发布计划 ID
 	Status         *string        `url:"status,omitempty"`           // 状态（系统状态 open/done，自定义状态可传中文）
 	Creator        *string        `url:"creator,omitempty"`          // 创建人
-	Created        *string        `url:"created,omitempty"`          // 创建时间 支持时间查询
-	Modified       *string        `url:"modified,omitempty"`         // 最后修改时间 支持时间查询
+	Created        *TimeQuery     `url:"created,omitempty"`          // 创建时间 支持时间查询
+	Modified       *TimeQuery     `url:"modified,omitempty"`         // 最后修改时间 支持时间查询
 	Completed      *string        `url:"completed,omitempty"`        // 完成时间
 	CustomField1   *string        `url:"custom_field_1,omitempty"`   // 自定义字段参数
 	CustomField2   *string        `url:"custom_field_2,omitempty"`   // 自定义字段参数
@@ -302,14 +333,15 @@ type GetIterationsCountRequest struct {
 	WorkspaceID    *int          `url:"workspace_id,omitempty"`     // 项目 ID
 	Name           *string       `url:"name,omitempty"`             // 标题 支持模糊匹配
 	Description    *string       `url:"description,omitempty"`      // 详细描述
-	StartDate      *string       `url:"startdate,omitempty"`        // 开始时间 支持时间查询
-	EndDate        *string       `url:"enddate,omitempty"`          // 结束时间 支持时间查询
+	StartDate      *TimeQuery    `url:"startdate,omitempty"`        // 开始时间 支持时间查询
+	EndDate        *TimeQuery    `url:"enddate,omitempty"`          // 结束时间 支持时间查询
 	WorkitemTypeID *int          `url:"workitem_type_id,omitempty"` // 迭代类别
 	PlanAppID      *int          `url:"plan_app_id,omitempty"`      // 计划应用 ID
+	ReleaseID      *int          `url:"release_id,omitempty"`       // 发布计划 ID
 	Status         *string       `url:"status,omitempty"`           // 状态（系统状态 open/done，自定义状态可传中文）
 	Creator        *string       `url:"creator,omitempty"`          // 创建人
-	Created        *string       `url:"created,omitempty"`          // 创建时间 支持时间查询
-	Modified       *string       `url:"modified,omitempty"`         // 最后修改时间 支持时间查询
+	Created        *TimeQuery    `url:"created,omitempty"`          // 创建时间 支持时间查询
+	Modified       *TimeQuery    `url:"modified,omitempty"`         // 最后修改时间 支持时间查询
 	Completed      *string       `url:"completed,omitempty"`        // 完成时间
 	CustomField1   *string       `url:"custom_field_1,omitempty"`   // 自定义字段参数
 	CustomField2   *string       `url:"custom_field_2,omitempty"`   // 自定义字段参数
@@ -448,11 +480,246 @@ type UpdateIterationRequest struct {
 	CustomField50 *string       `json:"custom_field_50,omitempty"` // 自定义字段参数
 }
 
-// 获取迭代变更历史
-// 获取迭代仪表盘自定义卡片内容
-// 修改迭代仪表盘自定义卡片内容
-// 锁定迭代
-// 解锁迭代
+// IterationChange 迭代变更记录
+type IterationChange struct {
+	ID             string                  `json:"id,omitempty"`               // ID
+	WorkspaceID    string                  `json:"workspace_id,omitempty"`     // 项目ID
+	IterationID    string                  `json:"iteration_id,omitempty"`     // 迭代ID
+	Creator        string                  `json:"creator,omitempty"`          // 变更人
+	Created        string                  `json:"created,omitempty"`          // 变更时间
+	ChangeSummary  string                  `json:"change_summary,omitempty"`   // 变更描述
+	Comment        string                  `json:"comment,omitempty"`          // 评论
+	Changes        string                  `json:"changes,omitempty"`          // 变更详细记录
+	EntityType     string                  `json:"entity_type,omitempty"`      // 变更的对象类型
+	ChangeType     string                  `json:"change_type,omitempty"`      // 变更类型
+	ChangeTypeText string                  `json:"change_type_text,omitempty"` // 变更类型说明
+	FieldChanges   []*IterationFieldChange `json:"field_changes,omitempty"`    // 字段变更
+}
+
+// IterationFieldChange 迭代字段变更
+type IterationFieldChange struct {
+	Field             string `json:"field,omitempty"`               // 字段名
+	FieldLabel        string `json:"field_label,omitempty"`         // 字段中文名
+	ValueBefore       string `json:"value_before,omitempty"`        // 变更前的值
+	ValueAfter        string `json:"value_after,omitempty"`         // 变更后的值
+	ValueBeforeParsed string `json:"value_before_parsed,omitempty"` // 变更前的值（已解析）
+	ValueAfterParsed  string `json:"value_after_parsed,omitempty"`  // 变更后的值（已解析）
+}
+
+// rawIterationChange 为了兼容自定义字段，value_before 和 value_after 可能不是字符串
+type rawIterationChange struct {
+	IterationChange
+	FieldChanges []struct {
+		IterationFieldChange
+		ValueBefore any `json:"value_before"`
+		ValueAfter  any `json:"value_after"`
+	} `json:"field_changes,omitempty"`
+}
+
+func parseRawIterationChange(raw *rawIterationChange) (*IterationChange, error) {
+	fieldChanges := make([]*IterationFieldChange, 0, len(raw.FieldChanges))
+
+	for _, rawFieldChange := range raw.FieldChanges {
+		fieldChange := rawFieldChange.IterationFieldChange
+
+		valueBefore, err := decodeGetTaskChangesFieldChangesValue(rawFieldChange.ValueBefore)
+		if err != nil {
+			return nil, err
+		}
+		fieldChange.ValueBefore = valueBefore
+
+		valueAfter, err := decodeGetTaskChangesFieldChangesValue(rawFieldChange.ValueAfter)
+		if err != nil {
+			return nil, err
+		}
+		fieldChange.ValueAfter = valueAfter
+
+		fieldChanges = append(fieldChanges, &fieldChange)
+	}
+
+	change := raw.IterationChange
+	change.FieldChanges = fieldChanges
+	return &change, nil
+}
+
+type GetIterationChangesRequest struct {
+	ID               *Multi[int64]  `url:"id,omitempty"`                 // ID，支持多ID查询
+	WorkspaceID      *int           `url:"workspace_id,omitempty"`       // [必须]项目ID
+	IterationID      *Multi[int64]  `url:"iteration_id,omitempty"`       // 迭代ID，支持多ID查询
+	Creator          *string        `url:"creator,omitempty"`            // 变更人
+	Created          *TimeQuery     `url:"created,omitempty"`            // 变更时间，支持时间查询
+	ChangeType       *string        `url:"change_type,omitempty"`        // 变更类型
+	NeedParseChanges *int           `url:"need_parse_changes,omitempty"` // 设置field_changes字段是否返回（默认取 1。取 0 则不返回）
+	Limit            *int           `url:"limit,omitempty"`              // 设置返回数量限制，默认为30
+	Page             *int           `url:"page,omitempty"`               // 返回当前数量限制下第N页的数据，默认为1（第一页）
+	Order            *Order         `url:"order,omitempty"`              // 排序规则，规则：字段名 ASC或者DESC
+	Fields           *Multi[string] `url:"fields,omitempty"`             // 设置获取的字段，多个字段间以','逗号隔开
+}
+
+// GetIterationChanges 获取迭代变更历史
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/iteration/get_iteration_changes.html
+func (s *IterationService) GetIterationChanges(
+	ctx context.Context, request *GetIterationChangesRequest, opts ...RequestOption,
+) ([]*IterationChange, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "iteration_changes", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var items []struct {
+		WorkitemChange *rawIterationChange `json:"WorkitemChange"`
+	}
+	resp, err := s.client.Do(req, &items)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	changes := make([]*IterationChange, 0, len(items))
+	for _, item := range items {
+		change, err := parseRawIterationChange(item.WorkitemChange)
+		if err != nil {
+			return nil, resp, err
+		}
+		changes = append(changes, change)
+	}
+
+	return changes, resp, nil
+}
+
+// IterationDashboardCard 迭代仪表盘自定义卡片
+type IterationDashboardCard struct {
+	ID          string `json:"id,omitempty"`           // 卡片ID
+	WorkspaceID string `json:"workspace_id,omitempty"` // 项目ID
+	IterationID string `json:"iteration_id,omitempty"` // 迭代ID
+	Title       string `json:"title,omitempty"`        // 卡片标题
+	Content     string `json:"content,omitempty"`      // 卡片内容，富文本
+	Creator     string `json:"creator,omitempty"`      // 创建人
+	Created     string `json:"created,omitempty"`      // 创建时间
+	Modifier    string `json:"modifier,omitempty"`     // 最后修改人
+	Modified    string `json:"modified,omitempty"`     // 最后修改时间
+}
+
+type GetDashboardCardsRequest struct {
+	WorkspaceID *int   `url:"workspace_id,omitempty"` // [必须]项目ID
+	IterationID *int64 `url:"iteration_id,omitempty"` // [必须]迭代ID
+}
+
+// GetDashboardCards 获取迭代仪表盘自定义卡片内容
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/iteration/get_dashboard_cards.html
+func (s *IterationService) GetDashboardCards(
+	ctx context.Context, request *GetDashboardCardsRequest, opts ...RequestOption,
+) ([]*IterationDashboardCard, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "iterations/dashboard_cards", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var items []struct {
+		DashboardCard *IterationDashboardCard `json:"DashboardCard"`
+	}
+	resp, err := s.client.Do(req, &items)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	cards := make([]*IterationDashboardCard, 0, len(items))
+	for _, item := range items {
+		cards = append(cards, item.DashboardCard)
+	}
+
+	return cards, resp, nil
+}
+
+type UpdateDashboardCardRequest struct {
+	WorkspaceID *int    `json:"workspace_id,omitempty"` // [必须]项目ID
+	IterationID *int64  `json:"iteration_id,omitempty"` // [必须]迭代ID
+	ID          *int64  `json:"id,omitempty"`           // [必须]卡片ID
+	Title       *string `json:"title,omitempty"`        // 卡片标题
+	Content     *string `json:"content,omitempty"`      // 卡片内容，富文本
+	CurrentUser *string `json:"current_user,omitempty"` // 变更人
+}
+
+// UpdateDashboardCard 修改迭代仪表盘自定义卡片内容
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/iteration/update_dashboard_card.html
+func (s *IterationService) UpdateDashboardCard(
+	ctx context.Context, request *UpdateDashboardCardRequest, opts ...RequestOption,
+) (*IterationDashboardCard, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "iterations/update_dashboard_card", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var item struct {
+		DashboardCard *IterationDashboardCard `json:"DashboardCard"`
+	}
+	resp, err := s.client.Do(req, &item)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return item.DashboardCard, resp, nil
+}
+
+type LockIterationRequest struct {
+	WorkspaceID *int    `json:"workspace_id,omitempty"` // [必须]项目ID
+	ID          *int64  `json:"id,omitempty"`           // [必须]迭代ID
+	CurrentUser *string `json:"current_user,omitempty"` // [必须]操作人
+}
+
+// LockIteration 锁定迭代
+//
+// 锁定后迭代及其规划的工作项不能再修改，直到解锁。
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/iteration/lock_iteration.html
+func (s *IterationService) LockIteration(
+	ctx context.Context, request *LockIterationRequest, opts ...RequestOption,
+) (*Iteration, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "iterations/lock", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var item struct {
+		Iteration *Iteration `json:"Iteration"`
+	}
+	resp, err := s.client.Do(req, &item)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return item.Iteration, resp, nil
+}
+
+type UnlockIterationRequest struct {
+	WorkspaceID *int    `json:"workspace_id,omitempty"` // [必须]项目ID
+	ID          *int64  `json:"id,omitempty"`           // [必须]迭代ID
+	CurrentUser *string `json:"current_user,omitempty"` // [必须]操作人
+}
+
+// UnlockIteration 解锁迭代
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/iteration/unlock_iteration.html
+func (s *IterationService) UnlockIteration(
+	ctx context.Context, request *UnlockIterationRequest, opts ...RequestOption,
+) (*Iteration, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "iterations/unlock", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var item struct {
+		Iteration *Iteration `json:"Iteration"`
+	}
+	resp, err := s.client.Do(req, &item)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return item.Iteration, resp, nil
+}
 
 // GetWorkitemTypes 获取迭代类别列表
 //
@@ -540,7 +807,72 @@ type WorkitemTemplate struct {
 	Modified    string `json:"modified"`
 }
 
-// 获取迭代模板字段配置
-// 获取迭代类别默认模板字段配置
+// IterationTemplateField 迭代模板字段配置
+type IterationTemplateField = StoryTemplateField
+
+type GetTemplateFieldsRequest struct {
+	WorkspaceID *int   `url:"workspace_id,omitempty"` // [必须]项目ID
+	TemplateID  *int64 `url:"template_id,omitempty"`  // [必须]模板ID
+}
+
+// GetTemplateFields 获取迭代模板字段配置
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/iteration/get_template_fields.html
+func (s *IterationService) GetTemplateFields(
+	ctx context.Context, request *GetTemplateFieldsRequest, opts ...RequestOption,
+) ([]*IterationTemplateField, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "iterations/template_fields", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var items []struct {
+		WorkitemTemplateField *IterationTemplateField `json:"WorkitemTemplateField"`
+	}
+	resp, err := s.client.Do(req, &items)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	fields := make([]*IterationTemplateField, 0, len(items))
+	for _, item := range items {
+		fields = append(fields, item.WorkitemTemplateField)
+	}
+
+	return fields, resp, nil
+}
+
+type GetDefaultTemplateFieldsRequest struct {
+	WorkspaceID    *int   `url:"workspace_id,omitempty"`     // [必须]项目ID
+	WorkitemTypeID *int64 `url:"workitem_type_id,omitempty"` // [必须]迭代类别ID
+}
+
+// GetDefaultTemplateFields 获取迭代类别默认模板字段配置
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/iteration/get_default_template_fields.html
+func (s *IterationService) GetDefaultTemplateFields(
+	ctx context.Context, request *GetDefaultTemplateFieldsRequest, opts ...RequestOption,
+) ([]*IterationTemplateField, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "iterations/default_template_fields", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var items []struct {
+		WorkitemTemplateField *IterationTemplateField `json:"WorkitemTemplateField"`
+	}
+	resp, err := s.client.Do(req, &items)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	fields := make([]*IterationTemplateField, 0, len(items))
+	for _, item := range items {
+		fields = append(fields, item.WorkitemTemplateField)
+	}
+
+	return fields, resp, nil
+}
+
 // 获取计划应用
 // 获取计划应用数量
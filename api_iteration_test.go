@@ -177,7 +177,7 @@ func TestIterationService_GetWorkitemTypes(t *testing.T) {
 	}))
 
 	workitemTypes, _, err := client.IterationService.GetWorkitemTypes(ctx, &GetWorkitemTypesRequest{
-		WorkspaceID: Ptr(111),
+		WorkspaceID: Ptr[int64](111),
 	})
 	assert.NoError(t, err)
 	require.NotNil(t, workitemTypes)
@@ -220,3 +220,204 @@ func TestIterationService_GetTemplateList(t *testing.T) {
 		},
 	}, templates)
 }
+
+func TestIterationService_GetIterationCustomFieldsSettings(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/iterations/custom_fields_settings", r.URL.Path)
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/iteration/get_iteration_custom_fields_settings.json"))
+	}))
+
+	settings, _, err := client.IterationService.GetIterationCustomFieldsSettings(ctx, &GetIterationCustomFieldsSettingsRequest{
+		WorkspaceID: Ptr(11112222),
+	})
+	assert.NoError(t, err)
+	require.Len(t, settings, 1)
+	assert.Equal(t, "iteration", settings[0].EntryType)
+	assert.Equal(t, "custom_field_1", settings[0].CustomField)
+	assert.Equal(t, "迭代目标", settings[0].Name)
+}
+
+func TestIterationService_GetIterationChanges(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/iteration_changes", r.URL.Path)
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+		assert.Equal(t, "1111112222001000501", r.URL.Query().Get("iteration_id"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/iteration/get_iteration_changes.json"))
+	}))
+
+	changes, _, err := client.IterationService.GetIterationChanges(ctx, &GetIterationChangesRequest{
+		WorkspaceID: Ptr(11112222),
+		IterationID: NewMulti[int64](1111112222001000501),
+	})
+	assert.NoError(t, err)
+	require.Len(t, changes, 1)
+	assert.Equal(t, "1111112222001000501", changes[0].IterationID)
+	assert.Equal(t, "update_iteration", changes[0].ChangeType)
+	require.Len(t, changes[0].FieldChanges, 2)
+	assert.Equal(t, "status", changes[0].FieldChanges[0].Field)
+	assert.Equal(t, "done", changes[0].FieldChanges[0].ValueAfter)
+	assert.Equal(t, "已完成", changes[0].FieldChanges[0].ValueAfterParsed)
+	assert.Equal(t, "13", changes[0].FieldChanges[1].ValueBefore)
+	assert.Equal(t, "21", changes[0].FieldChanges[1].ValueAfter)
+	assert.Equal(t, "21", changes[0].FieldChanges[1].ValueAfterParsed)
+}
+
+func TestIterationService_GetDashboardCards(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/iterations/dashboard_cards", r.URL.Path)
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+		assert.Equal(t, "1111112222001000501", r.URL.Query().Get("iteration_id"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/iteration/get_dashboard_cards.json"))
+	}))
+
+	cards, _, err := client.IterationService.GetDashboardCards(ctx, &GetDashboardCardsRequest{
+		WorkspaceID: Ptr(11112222),
+		IterationID: Ptr[int64](1111112222001000501),
+	})
+	assert.NoError(t, err)
+	require.Len(t, cards, 1)
+	assert.Equal(t, "1111112222001000531", cards[0].ID)
+	assert.Equal(t, "迭代总结", cards[0].Title)
+	assert.Equal(t, "<p>完成 8 个需求</p>", cards[0].Content)
+}
+
+func TestIterationService_UpdateDashboardCard(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/iterations/update_dashboard_card", r.URL.Path)
+
+		var req struct {
+			WorkspaceID int    `json:"workspace_id"`
+			IterationID int64  `json:"iteration_id"`
+			ID          int64  `json:"id"`
+			Content     string `json:"content"`
+			CurrentUser string `json:"current_user"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, 11112222, req.WorkspaceID)
+		assert.Equal(t, int64(1111112222001000501), req.IterationID)
+		assert.Equal(t, int64(1111112222001000531), req.ID)
+		assert.Equal(t, "<p>完成 12 个需求，遗留 1 个缺陷</p>", req.Content)
+		assert.Equal(t, "scrum-master", req.CurrentUser)
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/iteration/update_dashboard_card.json"))
+	}))
+
+	card, _, err := client.IterationService.UpdateDashboardCard(ctx, &UpdateDashboardCardRequest{
+		WorkspaceID: Ptr(11112222),
+		IterationID: Ptr[int64](1111112222001000501),
+		ID:          Ptr[int64](1111112222001000531),
+		Content:     Ptr("<p>完成 12 个需求，遗留 1 个缺陷</p>"),
+		CurrentUser: Ptr("scrum-master"),
+	})
+	assert.NoError(t, err)
+	require.NotNil(t, card)
+	assert.Equal(t, "<p>完成 12 个需求，遗留 1 个缺陷</p>", card.Content)
+	assert.Equal(t, "2025-02-14 18:00:00", card.Modified)
+}
+
+func TestIterationService_LockIteration(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/iterations/lock", r.URL.Path)
+
+		var req struct {
+			WorkspaceID int    `json:"workspace_id"`
+			ID          int64  `json:"id"`
+			CurrentUser string `json:"current_user"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, 11112222, req.WorkspaceID)
+		assert.Equal(t, int64(1111112222001000501), req.ID)
+		assert.Equal(t, "scrum-master", req.CurrentUser)
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/iteration/lock_iteration.json"))
+	}))
+
+	iteration, _, err := client.IterationService.LockIteration(ctx, &LockIterationRequest{
+		WorkspaceID: Ptr(11112222),
+		ID:          Ptr[int64](1111112222001000501),
+		CurrentUser: Ptr("scrum-master"),
+	})
+	assert.NoError(t, err)
+	require.NotNil(t, iteration)
+	assert.Equal(t, "1111112222001000501", iteration.ID)
+	assert.Equal(t, "scrum-master", iteration.Locker)
+}
+
+func TestIterationService_UnlockIteration(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/iterations/unlock", r.URL.Path)
+
+		var req struct {
+			WorkspaceID int    `json:"workspace_id"`
+			ID          int64  `json:"id"`
+			CurrentUser string `json:"current_user"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, 11112222, req.WorkspaceID)
+		assert.Equal(t, int64(1111112222001000501), req.ID)
+		assert.Equal(t, "scrum-master", req.CurrentUser)
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/iteration/unlock_iteration.json"))
+	}))
+
+	iteration, _, err := client.IterationService.UnlockIteration(ctx, &UnlockIterationRequest{
+		WorkspaceID: Ptr(11112222),
+		ID:          Ptr[int64](1111112222001000501),
+		CurrentUser: Ptr("scrum-master"),
+	})
+	assert.NoError(t, err)
+	require.NotNil(t, iteration)
+	assert.Equal(t, "1111112222001000501", iteration.ID)
+	assert.Empty(t, iteration.Locker)
+}
+
+func TestIterationService_GetTemplateFields(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/iterations/template_fields", r.URL.Path)
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+		assert.Equal(t, "1111112222001000541", r.URL.Query().Get("template_id"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/iteration/get_template_fields.json"))
+	}))
+
+	fields, _, err := client.IterationService.GetTemplateFields(ctx, &GetTemplateFieldsRequest{
+		WorkspaceID: Ptr(11112222),
+		TemplateID:  Ptr[int64](1111112222001000541),
+	})
+	assert.NoError(t, err)
+	require.Len(t, fields, 2)
+	assert.Equal(t, "name", fields[0].Field)
+	assert.Equal(t, "1", fields[0].Required)
+	assert.Equal(t, "description", fields[1].Field)
+}
+
+func TestIterationService_GetDefaultTemplateFields(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/iterations/default_template_fields", r.URL.Path)
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+		assert.Equal(t, "1111112222001000098", r.URL.Query().Get("workitem_type_id"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/iteration/get_default_template_fields.json"))
+	}))
+
+	fields, _, err := client.IterationService.GetDefaultTemplateFields(ctx, &GetDefaultTemplateFieldsRequest{
+		WorkspaceID:    Ptr(11112222),
+		WorkitemTypeID: Ptr[int64](1111112222001000098),
+	})
+	assert.NoError(t, err)
+	require.Len(t, fields, 2)
+	assert.Equal(t, "startdate", fields[1].Field)
+	assert.Equal(t, "1111112222001000541", fields[1].TemplateID)
+}
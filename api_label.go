@@ -50,7 +50,7 @@ type GetLabelsRequest struct {
 	ID          *Multi[int] `url:"id,omitempty"`           // [可选]id 支持多ID查询
 	Name        *string     `url:"name,omitempty"`         // [可选]标签名称 支持模糊匹配
 	Creator     *string     `url:"creator,omitempty"`      // [可选]创建人
-	Created     *string     `url:"created,omitempty"`      // [可选]创建时间 支持时间查询
+	Created     *TimeQuery  `url:"created,omitempty"`      // [可选]创建时间 支持时间查询
 	Limit       *int        `url:"limit,omitempty"`        // [可选]设置返回数量限制，默认为30
 	Page        *int        `url:"page,omitempty"`         // [可选]返回当前数量限制下第N页的数据，默认为1（第一页）
 	Order       *Order      `url:"order,omitempty"`        // [可选]排序规则，规则：字段名 ASC或者DESC，然后 urlencode 如按创建时间逆序
@@ -89,7 +89,7 @@ type GetLabelCountRequest struct {
 	ID          *Multi[int] `url:"id,omitempty"`           // [可选]id 支持多ID查询
 	Name        *string     `url:"name,omitempty"`         // [可选]标签名称 支持模糊匹配
 	Creator     *string     `url:"creator,omitempty"`      // [可选]创建人
-	Created     *string     `url:"created,omitempty"`      // [可选]创建时间 支持时间查询
+	Created     *TimeQuery  `url:"created,omitempty"`      // [可选]创建时间 支持时间查询
 }
 
 // GetLabelsCount 获取标签数量
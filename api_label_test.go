@@ -29,7 +29,7 @@ func TestLabelService_GetLabels(t *testing.T) {
 		ID:          NewMulti(111, 222),
 		Name:        Ptr("test"),
 		Creator:     Ptr("creator"),
-		Created:     Ptr("2024-08-26"),
+		Created:     NewTimeQuery("2024-08-26"),
 		Limit:       Ptr(10),
 		Page:        Ptr(1),
 		Order:       NewOrder("id", OrderByAsc),
@@ -66,7 +66,7 @@ func TestLabelService_GetLabelCount(t *testing.T) {
 		ID:          NewMulti(111, 222),
 		Name:        Ptr("test"),
 		Creator:     Ptr("creator"),
-		Created:     Ptr("2024-08-26"),
+		Created:     NewTimeQuery("2024-08-26"),
 	})
 	assert.NoError(t, err)
 	assert.Equal(t, 15, count)
@@ -0,0 +1,994 @@
+package tapd
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+)
+
+// LiteWorkitem 轻协作工作项
+type LiteWorkitem struct {
+	ID          string `json:"id,omitempty"`           // ID
+	WorkspaceID string `json:"workspace_id,omitempty"` // 空间ID
+	Name        string `json:"name,omitempty"`         // 标题
+	Description string `json:"description,omitempty"`  // 详细描述
+	Status      string `json:"status,omitempty"`       // 状态
+	Priority    string `json:"priority,omitempty"`     // 优先级
+	Owner       string `json:"owner,omitempty"`        // 处理人
+	Cc          string `json:"cc,omitempty"`           // 抄送人
+	Begin       string `json:"begin,omitempty"`        // 预计开始
+	Due         string `json:"due,omitempty"`          // 预计结束
+	GroupID     string `json:"group_id,omitempty"`     // 分组ID
+	ParentID    string `json:"parent_id,omitempty"`    // 父工作项ID
+	Label       string `json:"label,omitempty"`        // 标签
+	Creator     string `json:"creator,omitempty"`      // 创建人
+	Created     string `json:"created,omitempty"`      // 创建时间
+	Modified    string `json:"modified,omitempty"`     // 最后修改时间
+	Completed   string `json:"completed,omitempty"`    // 完成时间
+}
+
+// LiteWorkitemGroup 轻协作工作项分组
+type LiteWorkitemGroup struct {
+	ID          string `json:"id,omitempty"`           // ID
+	WorkspaceID string `json:"workspace_id,omitempty"` // 空间ID
+	Name        string `json:"name,omitempty"`         // 分组名称
+	Sort        string `json:"sort,omitempty"`         // 排序
+	Creator     string `json:"creator,omitempty"`      // 创建人
+	Created     string `json:"created,omitempty"`      // 创建时间
+	Modified    string `json:"modified,omitempty"`     // 最后修改时间
+}
+
+// LiteWorkitemActivity 轻协作工作项动态
+type LiteWorkitemActivity struct {
+	ID            string `json:"id,omitempty"`             // ID
+	WorkspaceID   string `json:"workspace_id,omitempty"`   // 空间ID
+	WorkitemID    string `json:"workitem_id,omitempty"`    // 工作项ID
+	Creator       string `json:"creator,omitempty"`        // 操作人
+	Created       string `json:"created,omitempty"`        // 操作时间
+	ChangeType    string `json:"change_type,omitempty"`    // 变更类型
+	ChangeSummary string `json:"change_summary,omitempty"` // 变更描述
+	Changes       string `json:"changes,omitempty"`        // 变更详细记录
+}
+
+// LiteWorkitemRelation 轻协作工作项与其他业务对象的关联关系
+type LiteWorkitemRelation struct {
+	WorkspaceID string     `json:"workspace_id,omitempty"` // 空间ID
+	WorkitemID  string     `json:"workitem_id,omitempty"`  // 工作项ID
+	TargetType  EntityType `json:"target_type,omitempty"`  // 关联对象类型
+	TargetID    string     `json:"target_id,omitempty"`    // 关联对象ID
+}
+
+// LiteRemovedWorkitem 回收站内的轻协作工作项
+type LiteRemovedWorkitem struct {
+	ID            string `json:"id,omitempty"`             // 工作项ID
+	Name          string `json:"name,omitempty"`           // 标题
+	Creator       string `json:"creator,omitempty"`        // 创建人
+	Created       string `json:"created,omitempty"`        // 创建时间
+	OperationUser string `json:"operation_user,omitempty"` // 删除人
+	Deleted       string `json:"deleted,omitempty"`        // 删除时间
+}
+
+// LiteSpace 轻协作空间
+type LiteSpace struct {
+	ID          string `json:"id,omitempty"`          // 空间ID
+	Name        string `json:"name,omitempty"`        // 空间名称
+	Description string `json:"description,omitempty"` // 空间描述
+	Status      string `json:"status,omitempty"`      // 状态
+	CompanyID   string `json:"company_id,omitempty"`  // 公司ID
+	Creator     string `json:"creator,omitempty"`     // 创建人
+	Created     string `json:"created,omitempty"`     // 创建时间
+}
+
+// LiteImage 上传的图片
+type LiteImage struct {
+	ImageSrc string `json:"image_src,omitempty"` // 图片地址
+	HTMLCode string `json:"html_code,omitempty"` // 可直接插入富文本的 HTML 代码
+}
+
+// LiteService 轻协作服务
+//
+// 轻协作空间的工作项、分组、动态、空间成员和附件。评论与源码关联沿用
+// CommentService 和 SourceCodeService。
+//
+// https://open.tapd.cn/document/api-doc/%E8%BD%BB%E5%8D%8F%E4%BD%9CAPI%E6%96%87%E6%A1%A3/
+type LiteService struct {
+	client *Client
+}
+
+// -----------------------------------------------------------------------------
+// 添加工作项
+// -----------------------------------------------------------------------------
+
+type CreateLiteWorkitemRequest struct {
+	WorkspaceID *int          `json:"workspace_id,omitempty"` // [必须]空间ID
+	Name        *string       `json:"name,omitempty"`         // [必须]标题
+	Description *string       `json:"description,omitempty"`  // 详细描述
+	Status      *string       `json:"status,omitempty"`       // 状态
+	Priority    *string       `json:"priority,omitempty"`     // 优先级
+	Owner       *string       `json:"owner,omitempty"`        // 处理人
+	Cc          *string       `json:"cc,omitempty"`           // 抄送人
+	Begin       *string       `json:"begin,omitempty"`        // 预计开始
+	Due         *string       `json:"due,omitempty"`          // 预计结束
+	GroupID     *int64        `json:"group_id,omitempty"`     // 分组ID
+	ParentID    *int64        `json:"parent_id,omitempty"`    // 父工作项ID
+	Label       *Enum[string] `json:"label,omitempty"`        // 标签，标签不存在时将自动创建
+	Creator     *string       `json:"creator,omitempty"`      // 创建人
+}
+
+// CreateWorkitem 添加工作项
+//
+// https://open.tapd.cn/document/api-doc/%E8%BD%BB%E5%8D%8F%E4%BD%9CAPI%E6%96%87%E6%A1%A3/workitem/add_workitem.html
+func (s *LiteService) CreateWorkitem(
+	ctx context.Context, request *CreateLiteWorkitemRequest, opts ...RequestOption,
+) (*LiteWorkitem, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "lite/workitems", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var item struct {
+		Workitem *LiteWorkitem `json:"Workitem"`
+	}
+	resp, err := s.client.Do(req, &item)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return item.Workitem, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 更新工作项
+// -----------------------------------------------------------------------------
+
+type UpdateLiteWorkitemRequest struct {
+	ID          *int64        `json:"id,omitempty"`           // [必须]ID
+	WorkspaceID *int          `json:"workspace_id,omitempty"` // [必须]空间ID
+	Name        *string       `json:"name,omitempty"`         // 标题
+	Description *string       `json:"description,omitempty"`  // 详细描述
+	Status      *string       `json:"status,omitempty"`       // 状态
+	Priority    *string       `json:"priority,omitempty"`     // 优先级
+	Owner       *string       `json:"owner,omitempty"`        // 处理人
+	Cc          *string       `json:"cc,omitempty"`           // 抄送人
+	Begin       *string       `json:"begin,omitempty"`        // 预计开始
+	Due         *string       `json:"due,omitempty"`          // 预计结束
+	GroupID     *int64        `json:"group_id,omitempty"`     // 分组ID
+	ParentID    *int64        `json:"parent_id,omitempty"`    // 父工作项ID
+	Label       *Enum[string] `json:"label,omitempty"`        // 标签
+	CurrentUser *string       `json:"current_user,omitempty"` // 变更人
+}
+
+// UpdateWorkitem 更新工作项
+//
+// https://open.tapd.cn/document/api-doc/%E8%BD%BB%E5%8D%8F%E4%BD%9CAPI%E6%96%87%E6%A1%A3/workitem/update_workitem.html
+func (s *LiteService) UpdateWorkitem(
+	ctx context.Context, request *UpdateLiteWorkitemRequest, opts ...RequestOption,
+) (*LiteWorkitem, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "lite/workitems", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var item struct {
+		Workitem *LiteWorkitem `json:"Workitem"`
+	}
+	resp, err := s.client.Do(req, &item)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return item.Workitem, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 获取工作项
+// -----------------------------------------------------------------------------
+
+type GetLiteWorkitemsRequest struct {
+	ID          *Multi[int64]  `url:"id,omitempty"`           // ID，支持多ID查询
+	WorkspaceID *int           `url:"workspace_id,omitempty"` // [必须]空间ID
+	Name        *string        `url:"name,omitempty"`         // 标题，支持模糊匹配
+	Status      *Enum[string]  `url:"status,omitempty"`       // 状态，支持枚举查询
+	Priority    *string        `url:"priority,omitempty"`     // 优先级
+	Owner       *string        `url:"owner,omitempty"`        // 处理人，支持模糊匹配
+	Creator     *string        `url:"creator,omitempty"`      // 创建人
+	GroupID     *Multi[int64]  `url:"group_id,omitempty"`     // 分组ID
+	ParentID    *int64         `url:"parent_id,omitempty"`    // 父工作项ID
+	Label       *string        `url:"label,omitempty"`        // 标签
+	Created     *TimeQuery     `url:"created,omitempty"`      // 创建时间，支持时间查询
+	Modified    *TimeQuery     `url:"modified,omitempty"`     // 最后修改时间，支持时间查询
+	Limit       *int           `url:"limit,omitempty"`        // 设置返回数量限制，默认为30
+	Page        *int           `url:"page,omitempty"`         // 返回当前数量限制下第N页的数据，默认为1（第一页）
+	Order       *Order         `url:"order,omitempty"`        // 排序规则，规则：字段名 ASC或者DESC
+	Fields      *Multi[string] `url:"fields,omitempty"`       // 设置获取的字段，多个字段间以','逗号隔开
+}
+
+// GetWorkitems 获取工作项
+//
+// https://open.tapd.cn/document/api-doc/%E8%BD%BB%E5%8D%8F%E4%BD%9CAPI%E6%96%87%E6%A1%A3/workitem/get_workitems.html
+func (s *LiteService) GetWorkitems(
+	ctx context.Context, request *GetLiteWorkitemsRequest, opts ...RequestOption,
+) ([]*LiteWorkitem, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "lite/workitems", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var items []struct {
+		Workitem *LiteWorkitem `json:"Workitem"`
+	}
+	resp, err := s.client.Do(req, &items)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	workitems := make([]*LiteWorkitem, 0, len(items))
+	for _, item := range items {
+		workitems = append(workitems, item.Workitem)
+	}
+
+	return workitems, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 获取工作项数量
+// -----------------------------------------------------------------------------
+
+type GetLiteWorkitemsCountRequest struct {
+	ID          *Multi[int64] `url:"id,omitempty"`           // ID，支持多ID查询
+	WorkspaceID *int          `url:"workspace_id,omitempty"` // [必须]空间ID
+	Name        *string       `url:"name,omitempty"`         // 标题，支持模糊匹配
+	Status      *Enum[string] `url:"status,omitempty"`       // 状态，支持枚举查询
+	Priority    *string       `url:"priority,omitempty"`     // 优先级
+	Owner       *string       `url:"owner,omitempty"`        // 处理人，支持模糊匹配
+	Creator     *string       `url:"creator,omitempty"`      // 创建人
+	GroupID     *Multi[int64] `url:"group_id,omitempty"`     // 分组ID
+	ParentID    *int64        `url:"parent_id,omitempty"`    // 父工作项ID
+	Label       *string       `url:"label,omitempty"`        // 标签
+	Created     *TimeQuery    `url:"created,omitempty"`      // 创建时间，支持时间查询
+	Modified    *TimeQuery    `url:"modified,omitempty"`     // 最后修改时间，支持时间查询
+}
+
+// GetWorkitemsCount 获取工作项数量
+//
+// https://open.tapd.cn/document/api-doc/%E8%BD%BB%E5%8D%8F%E4%BD%9CAPI%E6%96%87%E6%A1%A3/workitem/get_workitems_count.html
+func (s *LiteService) GetWorkitemsCount(
+	ctx context.Context, request *GetLiteWorkitemsCountRequest, opts ...RequestOption,
+) (int, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "lite/workitems/count", request, opts)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var response CountResponse
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return 0, resp, err
+	}
+
+	return response.Count, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 添加分组
+// -----------------------------------------------------------------------------
+
+type CreateLiteWorkitemGroupRequest struct {
+	WorkspaceID *int    `json:"workspace_id,omitempty"` // [必须]空间ID
+	Name        *string `json:"name,omitempty"`         // [必须]分组名称
+	Sort        *int    `json:"sort,omitempty"`         // 排序
+	Creator     *string `json:"creator,omitempty"`      // 创建人
+}
+
+// CreateWorkitemGroup 添加分组
+//
+// https://open.tapd.cn/document/api-doc/%E8%BD%BB%E5%8D%8F%E4%BD%9CAPI%E6%96%87%E6%A1%A3/workitem/add_group.html
+func (s *LiteService) CreateWorkitemGroup(
+	ctx context.Context, request *CreateLiteWorkitemGroupRequest, opts ...RequestOption,
+) (*LiteWorkitemGroup, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "lite/workitem_groups", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var item struct {
+		Group *LiteWorkitemGroup `json:"Group"`
+	}
+	resp, err := s.client.Do(req, &item)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return item.Group, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 更新分组
+// -----------------------------------------------------------------------------
+
+type UpdateLiteWorkitemGroupRequest struct {
+	ID          *int64  `json:"id,omitempty"`           // [必须]ID
+	WorkspaceID *int    `json:"workspace_id,omitempty"` // [必须]空间ID
+	Name        *string `json:"name,omitempty"`         // 分组名称
+	Sort        *int    `json:"sort,omitempty"`         // 排序
+	CurrentUser *string `json:"current_user,omitempty"` // 变更人
+}
+
+// UpdateWorkitemGroup 更新分组
+//
+// https://open.tapd.cn/document/api-doc/%E8%BD%BB%E5%8D%8F%E4%BD%9CAPI%E6%96%87%E6%A1%A3/workitem/update_group.html
+func (s *LiteService) UpdateWorkitemGroup(
+	ctx context.Context, request *UpdateLiteWorkitemGroupRequest, opts ...RequestOption,
+) (*LiteWorkitemGroup, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "lite/workitem_groups", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var item struct {
+		Group *LiteWorkitemGroup `json:"Group"`
+	}
+	resp, err := s.client.Do(req, &item)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return item.Group, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 获取分组
+// -----------------------------------------------------------------------------
+
+type GetLiteWorkitemGroupsRequest struct {
+	ID          *Multi[int64] `url:"id,omitempty"`           // ID，支持多ID查询
+	WorkspaceID *int          `url:"workspace_id,omitempty"` // [必须]空间ID
+	Name        *string       `url:"name,omitempty"`         // 分组名称，支持模糊匹配
+	Limit       *int          `url:"limit,omitempty"`        // 设置返回数量限制，默认为30
+	Page        *int          `url:"page,omitempty"`         // 返回当前数量限制下第N页的数据，默认为1（第一页）
+	Order       *Order        `url:"order,omitempty"`        // 排序规则，规则：字段名 ASC或者DESC
+}
+
+// GetWorkitemGroups 获取分组
+//
+// https://open.tapd.cn/document/api-doc/%E8%BD%BB%E5%8D%8F%E4%BD%9CAPI%E6%96%87%E6%A1%A3/workitem/get_groups.html
+func (s *LiteService) GetWorkitemGroups(
+	ctx context.Context, request *GetLiteWorkitemGroupsRequest, opts ...RequestOption,
+) ([]*LiteWorkitemGroup, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "lite/workitem_groups", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var items []struct {
+		Group *LiteWorkitemGroup `json:"Group"`
+	}
+	resp, err := s.client.Do(req, &items)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	groups := make([]*LiteWorkitemGroup, 0, len(items))
+	for _, item := range items {
+		groups = append(groups, item.Group)
+	}
+
+	return groups, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 获取分组数量
+// -----------------------------------------------------------------------------
+
+type GetLiteWorkitemGroupsCountRequest struct {
+	ID          *Multi[int64] `url:"id,omitempty"`           // ID，支持多ID查询
+	WorkspaceID *int          `url:"workspace_id,omitempty"` // [必须]空间ID
+	Name        *string       `url:"name,omitempty"`         // 分组名称，支持模糊匹配
+}
+
+// GetWorkitemGroupsCount 获取分组数量
+//
+// https://open.tapd.cn/document/api-doc/%E8%BD%BB%E5%8D%8F%E4%BD%9CAPI%E6%96%87%E6%A1%A3/workitem/get_groups_count.html
+func (s *LiteService) GetWorkitemGroupsCount(
+	ctx context.Context, request *GetLiteWorkitemGroupsCountRequest, opts ...RequestOption,
+) (int, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "lite/workitem_groups/count", request, opts)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var response CountResponse
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return 0, resp, err
+	}
+
+	return response.Count, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 获取工作项动态
+// -----------------------------------------------------------------------------
+
+type GetLiteWorkitemActivitiesRequest struct {
+	WorkspaceID *int          `url:"workspace_id,omitempty"` // [必须]空间ID
+	WorkitemID  *Multi[int64] `url:"workitem_id,omitempty"`  // 工作项ID，支持多ID查询
+	Creator     *string       `url:"creator,omitempty"`      // 操作人
+	Created     *TimeQuery    `url:"created,omitempty"`      // 操作时间，支持时间查询
+	Limit       *int          `url:"limit,omitempty"`        // 设置返回数量限制，默认为30
+	Page        *int          `url:"page,omitempty"`         // 返回当前数量限制下第N页的数据，默认为1（第一页）
+	Order       *Order        `url:"order,omitempty"`        // 排序规则，规则：字段名 ASC或者DESC
+}
+
+// GetWorkitemActivities 获取工作项动态
+//
+// https://open.tapd.cn/document/api-doc/%E8%BD%BB%E5%8D%8F%E4%BD%9CAPI%E6%96%87%E6%A1%A3/workitem/get_activities.html
+func (s *LiteService) GetWorkitemActivities(
+	ctx context.Context, request *GetLiteWorkitemActivitiesRequest, opts ...RequestOption,
+) ([]*LiteWorkitemActivity, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "lite/workitem_activities", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var items []struct {
+		Activity *LiteWorkitemActivity `json:"Activity"`
+	}
+	resp, err := s.client.Do(req, &items)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	activities := make([]*LiteWorkitemActivity, 0, len(items))
+	for _, item := range items {
+		activities = append(activities, item.Activity)
+	}
+
+	return activities, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 获取工作项动态数量
+// -----------------------------------------------------------------------------
+
+type GetLiteWorkitemActivitiesCountRequest struct {
+	WorkspaceID *int          `url:"workspace_id,omitempty"` // [必须]空间ID
+	WorkitemID  *Multi[int64] `url:"workitem_id,omitempty"`  // 工作项ID，支持多ID查询
+	Creator     *string       `url:"creator,omitempty"`      // 操作人
+	Created     *TimeQuery    `url:"created,omitempty"`      // 操作时间，支持时间查询
+}
+
+// GetWorkitemActivitiesCount 获取工作项动态数量
+//
+// https://open.tapd.cn/document/api-doc/%E8%BD%BB%E5%8D%8F%E4%BD%9CAPI%E6%96%87%E6%A1%A3/workitem/get_activities_count.html
+func (s *LiteService) GetWorkitemActivitiesCount(
+	ctx context.Context, request *GetLiteWorkitemActivitiesCountRequest, opts ...RequestOption,
+) (int, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "lite/workitem_activities/count", request, opts)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var response CountResponse
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return 0, resp, err
+	}
+
+	return response.Count, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 获取工作项自定义字段配置
+// -----------------------------------------------------------------------------
+
+type GetLiteWorkitemCustomFieldsSettingsRequest struct {
+	WorkspaceID *int `url:"workspace_id,omitempty"` // [必须]空间ID
+}
+
+// GetWorkitemCustomFieldsSettings 获取工作项自定义字段配置
+//
+// https://open.tapd.cn/document/api-doc/%E8%BD%BB%E5%8D%8F%E4%BD%9CAPI%E6%96%87%E6%A1%A3/workitem/get_custom_fields_settings.html
+func (s *LiteService) GetWorkitemCustomFieldsSettings(
+	ctx context.Context, request *GetLiteWorkitemCustomFieldsSettingsRequest, opts ...RequestOption,
+) ([]*CustomFieldsSetting, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "lite/workitems/custom_fields_settings", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var items []struct {
+		CustomFieldConfig *CustomFieldsSetting `json:"CustomFieldConfig"`
+	}
+	resp, err := s.client.Do(req, &items)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	settings := make([]*CustomFieldsSetting, 0, len(items))
+	for _, item := range items {
+		settings = append(settings, item.CustomFieldConfig)
+	}
+
+	return settings, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 获取工作项所有字段的中英文
+// -----------------------------------------------------------------------------
+
+type GetLiteWorkitemFieldsLabelRequest struct {
+	WorkspaceID *int `url:"workspace_id,omitempty"` // [必须]空间ID
+}
+
+// GetWorkitemFieldsLabel 获取工作项所有字段的中英文，返回字段英文名到中文名的映射
+//
+// https://open.tapd.cn/document/api-doc/%E8%BD%BB%E5%8D%8F%E4%BD%9CAPI%E6%96%87%E6%A1%A3/workitem/get_fields_label.html
+func (s *LiteService) GetWorkitemFieldsLabel(
+	ctx context.Context, request *GetLiteWorkitemFieldsLabelRequest, opts ...RequestOption,
+) (map[string]string, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "lite/workitems/get_fields_label", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var labels map[string]string
+	resp, err := s.client.Do(req, &labels)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return labels, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 添加工作项与其他业务对象的关联关系
+// -----------------------------------------------------------------------------
+
+type CreateLiteWorkitemRelationRequest struct {
+	WorkspaceID *int          `json:"workspace_id,omitempty"` // [必须]空间ID
+	WorkitemID  *int64        `json:"workitem_id,omitempty"`  // [必须]工作项ID
+	TargetType  *EntityType   `json:"target_type,omitempty"`  // [必须]关联对象类型，可选值：story,bug
+	TargetID    *Multi[int64] `json:"target_id,omitempty"`    // [必须]关联对象ID
+}
+
+// CreateWorkitemRelation 添加工作项与其他业务对象的关联关系
+//
+// https://open.tapd.cn/document/api-doc/%E8%BD%BB%E5%8D%8F%E4%BD%9CAPI%E6%96%87%E6%A1%A3/workitem/add_relation.html
+func (s *LiteService) CreateWorkitemRelation(
+	ctx context.Context, request *CreateLiteWorkitemRelationRequest, opts ...RequestOption,
+) ([]*LiteWorkitemRelation, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "lite/relations", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var relations []*LiteWorkitemRelation
+	resp, err := s.client.Do(req, &relations)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return relations, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 获取关联需求
+// -----------------------------------------------------------------------------
+
+type GetLiteWorkitemRelatedStoriesRequest struct {
+	WorkspaceID *int          `url:"workspace_id,omitempty"` // [必须]空间ID
+	WorkitemID  *Multi[int64] `url:"workitem_id,omitempty"`  // [必须]工作项ID，支持多ID查询
+}
+
+// GetWorkitemRelatedStories 获取关联需求
+//
+// https://open.tapd.cn/document/api-doc/%E8%BD%BB%E5%8D%8F%E4%BD%9CAPI%E6%96%87%E6%A1%A3/workitem/get_related_stories.html
+func (s *LiteService) GetWorkitemRelatedStories(
+	ctx context.Context, request *GetLiteWorkitemRelatedStoriesRequest, opts ...RequestOption,
+) ([]*LiteWorkitemRelation, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "lite/workitems/get_related_stories", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var relations []*LiteWorkitemRelation
+	resp, err := s.client.Do(req, &relations)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return relations, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 获取关联缺陷
+// -----------------------------------------------------------------------------
+
+type GetLiteWorkitemRelatedBugsRequest struct {
+	WorkspaceID *int          `url:"workspace_id,omitempty"` // [必须]空间ID
+	WorkitemID  *Multi[int64] `url:"workitem_id,omitempty"`  // [必须]工作项ID，支持多ID查询
+}
+
+// GetWorkitemRelatedBugs 获取关联缺陷
+//
+// https://open.tapd.cn/document/api-doc/%E8%BD%BB%E5%8D%8F%E4%BD%9CAPI%E6%96%87%E6%A1%A3/workitem/get_related_bugs.html
+func (s *LiteService) GetWorkitemRelatedBugs(
+	ctx context.Context, request *GetLiteWorkitemRelatedBugsRequest, opts ...RequestOption,
+) ([]*LiteWorkitemRelation, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "lite/workitems/get_related_bugs", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var relations []*LiteWorkitemRelation
+	resp, err := s.client.Do(req, &relations)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return relations, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 解除工作项与其他业务对象的关联关系
+// -----------------------------------------------------------------------------
+
+type RemoveLiteWorkitemRelationRequest struct {
+	WorkspaceID *int          `json:"workspace_id,omitempty"` // [必须]空间ID
+	WorkitemID  *int64        `json:"workitem_id,omitempty"`  // [必须]工作项ID
+	TargetType  *EntityType   `json:"target_type,omitempty"`  // [必须]关联对象类型，可选值：story,bug
+	TargetID    *Multi[int64] `json:"target_id,omitempty"`    // [必须]关联对象ID
+}
+
+// RemoveWorkitemRelation 解除工作项与其他业务对象的关联关系
+//
+// https://open.tapd.cn/document/api-doc/%E8%BD%BB%E5%8D%8F%E4%BD%9CAPI%E6%96%87%E6%A1%A3/workitem/remove_relation.html
+func (s *LiteService) RemoveWorkitemRelation(
+	ctx context.Context, request *RemoveLiteWorkitemRelationRequest, opts ...RequestOption,
+) (*Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "lite/relations/delete", request, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// -----------------------------------------------------------------------------
+// 获取回收站内的工作项
+// -----------------------------------------------------------------------------
+
+type GetLiteRemovedWorkitemsRequest struct {
+	WorkspaceID *int          `url:"workspace_id,omitempty"` // [必须]空间ID
+	ID          *Multi[int64] `url:"id,omitempty"`           // 工作项ID
+	Creator     *string       `url:"creator,omitempty"`      // 创建人
+	Created     *string       `url:"created,omitempty"`      // 创建时间
+	Deleted     *string       `url:"deleted,omitempty"`      // 删除时间
+	Limit       *int          `url:"limit,omitempty"`        // 设置返回数量限制，默认为30
+	Page        *int          `url:"page,omitempty"`         // 返回当前数量限制下第N页的数据，默认为1（第一页）
+}
+
+// GetRemovedWorkitems 获取回收站内的工作项
+//
+// https://open.tapd.cn/document/api-doc/%E8%BD%BB%E5%8D%8F%E4%BD%9CAPI%E6%96%87%E6%A1%A3/workitem/get_removed_workitems.html
+func (s *LiteService) GetRemovedWorkitems(
+	ctx context.Context, request *GetLiteRemovedWorkitemsRequest, opts ...RequestOption,
+) ([]*LiteRemovedWorkitem, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "lite/workitems/get_removed_workitems", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var items []struct {
+		RemovedWorkitem *LiteRemovedWorkitem `json:"RemovedWorkitem"`
+	}
+	resp, err := s.client.Do(req, &items)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	workitems := make([]*LiteRemovedWorkitem, 0, len(items))
+	for _, item := range items {
+		workitems = append(workitems, item.RemovedWorkitem)
+	}
+
+	return workitems, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 获取空间信息
+// -----------------------------------------------------------------------------
+
+type GetSpaceInfoRequest struct {
+	WorkspaceID *int `url:"workspace_id,omitempty"` // [必须]空间ID
+}
+
+// GetSpaceInfo 获取空间信息
+//
+// https://open.tapd.cn/document/api-doc/%E8%BD%BB%E5%8D%8F%E4%BD%9CAPI%E6%96%87%E6%A1%A3/space/get_space_info.html
+func (s *LiteService) GetSpaceInfo(
+	ctx context.Context, request *GetSpaceInfoRequest, opts ...RequestOption,
+) (*LiteSpace, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "lite/spaces/get_space_info", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var item struct {
+		Space *LiteSpace `json:"Space"`
+	}
+	resp, err := s.client.Do(req, &item)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return item.Space, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 添加空间成员
+// -----------------------------------------------------------------------------
+
+type AddSpaceMemberRequest struct {
+	WorkspaceID *int          `json:"workspace_id,omitempty"` // [必须]空间ID
+	Nick        *string       `json:"nick,omitempty"`         // [必须]用户昵称
+	RoleID      *Multi[int64] `json:"role_id,omitempty"`      // 角色ID
+	Creator     *string       `json:"creator,omitempty"`      // 操作人
+}
+
+// AddSpaceMember 添加空间成员
+//
+// https://open.tapd.cn/document/api-doc/%E8%BD%BB%E5%8D%8F%E4%BD%9CAPI%E6%96%87%E6%A1%A3/space/add_member.html
+func (s *LiteService) AddSpaceMember(
+	ctx context.Context, request *AddSpaceMemberRequest, opts ...RequestOption,
+) (*UserWorkspace, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "lite/spaces/add_member", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var item struct {
+		UserWorkspace *UserWorkspace `json:"UserWorkspace"`
+	}
+	resp, err := s.client.Do(req, &item)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return item.UserWorkspace, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 获取空间成员列表
+// -----------------------------------------------------------------------------
+
+type GetSpaceMembersRequest struct {
+	WorkspaceID *int           `url:"workspace_id,omitempty"` // [必须]空间ID
+	User        *string        `url:"user,omitempty"`         // 用户昵称
+	Fields      *Multi[string] `url:"fields,omitempty"`       // 设置获取的字段，多个字段间以','逗号隔开
+}
+
+// GetSpaceMembers 获取空间成员列表
+//
+// https://open.tapd.cn/document/api-doc/%E8%BD%BB%E5%8D%8F%E4%BD%9CAPI%E6%96%87%E6%A1%A3/space/users.html
+func (s *LiteService) GetSpaceMembers(
+	ctx context.Context, request *GetSpaceMembersRequest, opts ...RequestOption,
+) ([]*UserWorkspace, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "lite/spaces/users", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var items []struct {
+		UserWorkspace *UserWorkspace `json:"UserWorkspace"`
+	}
+	resp, err := s.client.Do(req, &items)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	members := make([]*UserWorkspace, 0, len(items))
+	for _, item := range items {
+		members = append(members, item.UserWorkspace)
+	}
+
+	return members, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 新建空间
+// -----------------------------------------------------------------------------
+
+type CreateSpaceRequest struct {
+	CompanyID   *int           `json:"company_id,omitempty"`  // [必须]公司ID
+	Name        *string        `json:"name,omitempty"`        // [必须]空间名称
+	Description *string        `json:"description,omitempty"` // 空间描述
+	Members     *Multi[string] `json:"members,omitempty"`     // 空间成员昵称
+	Creator     *string        `json:"creator,omitempty"`     // [必须]创建人，创建后为空间管理员
+}
+
+// CreateSpace 新建空间
+//
+// https://open.tapd.cn/document/api-doc/%E8%BD%BB%E5%8D%8F%E4%BD%9CAPI%E6%96%87%E6%A1%A3/space/add_space.html
+func (s *LiteService) CreateSpace(
+	ctx context.Context, request *CreateSpaceRequest, opts ...RequestOption,
+) (*LiteSpace, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "lite/spaces", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var item struct {
+		Space *LiteSpace `json:"Space"`
+	}
+	resp, err := s.client.Do(req, &item)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return item.Space, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 获取用户所有参与的空间
+// -----------------------------------------------------------------------------
+
+type GetUserSpacesRequest struct {
+	Nick      *string `url:"nick,omitempty"`       // [必须]用户昵称
+	CompanyID *int    `url:"company_id,omitempty"` // 公司ID
+}
+
+// GetUserSpaces 获取用户所有参与的空间
+//
+// https://open.tapd.cn/document/api-doc/%E8%BD%BB%E5%8D%8F%E4%BD%9CAPI%E6%96%87%E6%A1%A3/space/user_participant_spaces.html
+func (s *LiteService) GetUserSpaces(
+	ctx context.Context, request *GetUserSpacesRequest, opts ...RequestOption,
+) ([]*LiteSpace, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "lite/spaces/user_participant_spaces", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var items []struct {
+		Space *LiteSpace `json:"Space"`
+	}
+	resp, err := s.client.Do(req, &items)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	spaces := make([]*LiteSpace, 0, len(items))
+	for _, item := range items {
+		spaces = append(spaces, item.Space)
+	}
+
+	return spaces, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 附件上传
+// -----------------------------------------------------------------------------
+
+type UploadLiteAttachmentRequest struct {
+	WorkspaceID *int      `url:"workspace_id,omitempty"` // [必须]空间ID
+	Type        *string   `url:"type,omitempty"`         // [必须]依赖对象类型，如 workitem
+	EntryID     *int64    `url:"entry_id,omitempty"`     // [必须]依赖对象ID
+	Owner       *string   `url:"owner,omitempty"`        // 上传人
+	Filename    string    `url:"-"`                      // [必须]附件名称
+	File        io.Reader `url:"-"`                      // [必须]附件内容
+}
+
+// UploadAttachment 附件上传
+//
+// https://open.tapd.cn/document/api-doc/%E8%BD%BB%E5%8D%8F%E4%BD%9CAPI%E6%96%87%E6%A1%A3/attachment/upload_attachment.html
+func (s *LiteService) UploadAttachment(
+	ctx context.Context, request *UploadLiteAttachmentRequest, opts ...RequestOption,
+) (*Attachment, *Response, error) {
+	if request == nil || request.File == nil {
+		return nil, nil, errors.New("tapd: UploadAttachment requires a request with File")
+	}
+
+	req, err := s.client.NewUploadRequest(ctx, "lite/attachments/upload", request,
+		request.Filename, request.File, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var item struct {
+		Attachment *Attachment `json:"Attachment"`
+	}
+	resp, err := s.client.Do(req, &item)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return item.Attachment, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 上传base64图片
+// -----------------------------------------------------------------------------
+
+type UploadLiteImageRequest struct {
+	WorkspaceID *int    `json:"workspace_id,omitempty"` // [必须]空间ID
+	Base64Data  *string `json:"base64_data,omitempty"`  // [必须]图片内容，形如 data:image/png;base64,xxx
+}
+
+// UploadImage 上传base64图片
+//
+// https://open.tapd.cn/document/api-doc/%E8%BD%BB%E5%8D%8F%E4%BD%9CAPI%E6%96%87%E6%A1%A3/attachment/upload_base64_image.html
+func (s *LiteService) UploadImage(
+	ctx context.Context, request *UploadLiteImageRequest, opts ...RequestOption,
+) (*LiteImage, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "lite/attachments/upload_base64_image", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	image := new(LiteImage)
+	resp, err := s.client.Do(req, image)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return image, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 获取单个附件下载链接
+// -----------------------------------------------------------------------------
+
+// GetAttachmentDownloadURL 获取单个附件下载链接
+//
+// https://open.tapd.cn/document/api-doc/%E8%BD%BB%E5%8D%8F%E4%BD%9CAPI%E6%96%87%E6%A1%A3/attachment/get_one_attachment.html
+func (s *LiteService) GetAttachmentDownloadURL(
+	ctx context.Context, request *GetAttachmentDownloadURLRequest, opts ...RequestOption,
+) (*Attachment, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "lite/attachments/down", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var item struct {
+		Attachment *Attachment `json:"Attachment"`
+	}
+	resp, err := s.client.Do(req, &item)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return item.Attachment, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 获取附件
+// -----------------------------------------------------------------------------
+
+// GetAttachments 获取附件
+//
+// https://open.tapd.cn/document/api-doc/%E8%BD%BB%E5%8D%8F%E4%BD%9CAPI%E6%96%87%E6%A1%A3/attachment/get_attachments.html
+func (s *LiteService) GetAttachments(
+	ctx context.Context, request *GetAttachmentsRequest, opts ...RequestOption,
+) ([]*Attachment, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "lite/attachments", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var items []struct {
+		Attachment *Attachment `json:"Attachment"`
+	}
+	resp, err := s.client.Do(req, &items)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	attachments := make([]*Attachment, 0, len(items))
+	for _, item := range items {
+		attachments = append(attachments, item.Attachment)
+	}
+
+	return attachments, resp, nil
+}
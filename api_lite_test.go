@@ -0,0 +1,657 @@
+package tapd
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLiteService_CreateWorkitem(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/lite/workitems", r.URL.Path)
+
+		var req struct {
+			WorkspaceID int    `json:"workspace_id"`
+			Name        string `json:"name"`
+			Owner       string `json:"owner"`
+			GroupID     int64  `json:"group_id"`
+			Label       string `json:"label"`
+			Creator     string `json:"creator"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, 22223333, req.WorkspaceID)
+		assert.Equal(t, "准备发布会物料", req.Name)
+		assert.Equal(t, "alice", req.Owner)
+		assert.Equal(t, int64(1111112222001000611), req.GroupID)
+		assert.Equal(t, "市场|发布会", req.Label)
+		assert.Equal(t, "alice", req.Creator)
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/lite/create_workitem.json"))
+	}))
+
+	workitem, _, err := client.LiteService.CreateWorkitem(ctx, &CreateLiteWorkitemRequest{
+		WorkspaceID: Ptr(22223333),
+		Name:        Ptr("准备发布会物料"),
+		Owner:       Ptr("alice"),
+		GroupID:     Ptr[int64](1111112222001000611),
+		Label:       NewEnum("市场", "发布会"),
+		Creator:     Ptr("alice"),
+	})
+	require.NoError(t, err)
+	require.NotNil(t, workitem)
+	assert.Equal(t, "1111112222001000601", workitem.ID)
+	assert.Equal(t, "22223333", workitem.WorkspaceID)
+	assert.Equal(t, "open", workitem.Status)
+	assert.Equal(t, "1111112222001000611", workitem.GroupID)
+}
+
+func TestLiteService_UpdateWorkitem(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/lite/workitems", r.URL.Path)
+
+		var req struct {
+			ID          int64  `json:"id"`
+			WorkspaceID int    `json:"workspace_id"`
+			Status      string `json:"status"`
+			CurrentUser string `json:"current_user"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, int64(1111112222001000601), req.ID)
+		assert.Equal(t, 22223333, req.WorkspaceID)
+		assert.Equal(t, "done", req.Status)
+		assert.Equal(t, "alice", req.CurrentUser)
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/lite/update_workitem.json"))
+	}))
+
+	workitem, _, err := client.LiteService.UpdateWorkitem(ctx, &UpdateLiteWorkitemRequest{
+		ID:          Ptr[int64](1111112222001000601),
+		WorkspaceID: Ptr(22223333),
+		Status:      Ptr("done"),
+		CurrentUser: Ptr("alice"),
+	})
+	require.NoError(t, err)
+	require.NotNil(t, workitem)
+	assert.Equal(t, "done", workitem.Status)
+	assert.Equal(t, "2025-03-14 17:30:00", workitem.Completed)
+}
+
+func TestLiteService_GetWorkitems(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/lite/workitems", r.URL.Path)
+
+		assert.Equal(t, "22223333", r.URL.Query().Get("workspace_id"))
+		assert.Equal(t, "open|progressing", r.URL.Query().Get("status"))
+		assert.Equal(t, "1111112222001000611", r.URL.Query().Get("group_id"))
+		assert.Equal(t, "id,name,status", r.URL.Query().Get("fields"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/lite/get_workitems.json"))
+	}))
+
+	workitems, _, err := client.LiteService.GetWorkitems(ctx, &GetLiteWorkitemsRequest{
+		WorkspaceID: Ptr(22223333),
+		Status:      NewEnum("open", "progressing"),
+		GroupID:     NewMulti[int64](1111112222001000611),
+		Fields:      NewMulti("id", "name", "status"),
+	})
+	require.NoError(t, err)
+	require.Len(t, workitems, 2)
+	assert.Equal(t, "1111112222001000601", workitems[0].ID)
+	assert.Equal(t, "邀请媒体", workitems[1].Name)
+	assert.Equal(t, "progressing", workitems[1].Status)
+}
+
+func TestLiteService_GetWorkitemsCount(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/lite/workitems/count", r.URL.Path)
+
+		assert.Equal(t, "22223333", r.URL.Query().Get("workspace_id"))
+		assert.Equal(t, "alice", r.URL.Query().Get("owner"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/lite/get_workitems_count.json"))
+	}))
+
+	count, _, err := client.LiteService.GetWorkitemsCount(ctx, &GetLiteWorkitemsCountRequest{
+		WorkspaceID: Ptr(22223333),
+		Owner:       Ptr("alice"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+}
+
+func TestLiteService_CreateWorkitemGroup(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/lite/workitem_groups", r.URL.Path)
+
+		var req struct {
+			WorkspaceID int    `json:"workspace_id"`
+			Name        string `json:"name"`
+			Sort        int    `json:"sort"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, 22223333, req.WorkspaceID)
+		assert.Equal(t, "发布会", req.Name)
+		assert.Equal(t, 1, req.Sort)
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/lite/create_workitem_group.json"))
+	}))
+
+	group, _, err := client.LiteService.CreateWorkitemGroup(ctx, &CreateLiteWorkitemGroupRequest{
+		WorkspaceID: Ptr(22223333),
+		Name:        Ptr("发布会"),
+		Sort:        Ptr(1),
+	})
+	require.NoError(t, err)
+	require.NotNil(t, group)
+	assert.Equal(t, "1111112222001000611", group.ID)
+	assert.Equal(t, "发布会", group.Name)
+}
+
+func TestLiteService_UpdateWorkitemGroup(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/lite/workitem_groups", r.URL.Path)
+
+		var req struct {
+			ID          int64  `json:"id"`
+			WorkspaceID int    `json:"workspace_id"`
+			Name        string `json:"name"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, int64(1111112222001000611), req.ID)
+		assert.Equal(t, 22223333, req.WorkspaceID)
+		assert.Equal(t, "春季发布会", req.Name)
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/lite/update_workitem_group.json"))
+	}))
+
+	group, _, err := client.LiteService.UpdateWorkitemGroup(ctx, &UpdateLiteWorkitemGroupRequest{
+		ID:          Ptr[int64](1111112222001000611),
+		WorkspaceID: Ptr(22223333),
+		Name:        Ptr("春季发布会"),
+	})
+	require.NoError(t, err)
+	require.NotNil(t, group)
+	assert.Equal(t, "春季发布会", group.Name)
+	assert.Equal(t, "2025-03-05 11:00:00", group.Modified)
+}
+
+func TestLiteService_GetWorkitemGroups(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/lite/workitem_groups", r.URL.Path)
+
+		assert.Equal(t, "22223333", r.URL.Query().Get("workspace_id"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/lite/get_workitem_groups.json"))
+	}))
+
+	groups, _, err := client.LiteService.GetWorkitemGroups(ctx, &GetLiteWorkitemGroupsRequest{
+		WorkspaceID: Ptr(22223333),
+	})
+	require.NoError(t, err)
+	require.Len(t, groups, 2)
+	assert.Equal(t, "春季发布会", groups[0].Name)
+	assert.Equal(t, "2", groups[1].Sort)
+}
+
+func TestLiteService_GetWorkitemGroupsCount(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/lite/workitem_groups/count", r.URL.Path)
+
+		assert.Equal(t, "22223333", r.URL.Query().Get("workspace_id"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/lite/get_workitem_groups_count.json"))
+	}))
+
+	count, _, err := client.LiteService.GetWorkitemGroupsCount(ctx, &GetLiteWorkitemGroupsCountRequest{
+		WorkspaceID: Ptr(22223333),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+}
+
+func TestLiteService_GetWorkitemActivities(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/lite/workitem_activities", r.URL.Path)
+
+		assert.Equal(t, "22223333", r.URL.Query().Get("workspace_id"))
+		assert.Equal(t, "1111112222001000601", r.URL.Query().Get("workitem_id"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/lite/get_workitem_activities.json"))
+	}))
+
+	activities, _, err := client.LiteService.GetWorkitemActivities(ctx, &GetLiteWorkitemActivitiesRequest{
+		WorkspaceID: Ptr(22223333),
+		WorkitemID:  NewMulti[int64](1111112222001000601),
+	})
+	require.NoError(t, err)
+	require.Len(t, activities, 1)
+	assert.Equal(t, "1111112222001000601", activities[0].WorkitemID)
+	assert.Equal(t, "update", activities[0].ChangeType)
+	assert.Equal(t, "状态：进行中 → 已完成", activities[0].ChangeSummary)
+}
+
+func TestLiteService_GetWorkitemActivitiesCount(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/lite/workitem_activities/count", r.URL.Path)
+
+		assert.Equal(t, "22223333", r.URL.Query().Get("workspace_id"))
+		assert.Equal(t, "1111112222001000601", r.URL.Query().Get("workitem_id"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/lite/get_workitem_activities_count.json"))
+	}))
+
+	count, _, err := client.LiteService.GetWorkitemActivitiesCount(ctx, &GetLiteWorkitemActivitiesCountRequest{
+		WorkspaceID: Ptr(22223333),
+		WorkitemID:  NewMulti[int64](1111112222001000601),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+}
+
+func TestLiteService_GetWorkitemCustomFieldsSettings(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/lite/workitems/custom_fields_settings", r.URL.Path)
+
+		assert.Equal(t, "22223333", r.URL.Query().Get("workspace_id"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/lite/get_workitem_custom_fields_settings.json"))
+	}))
+
+	settings, _, err := client.LiteService.GetWorkitemCustomFieldsSettings(ctx, &GetLiteWorkitemCustomFieldsSettingsRequest{
+		WorkspaceID: Ptr(22223333),
+	})
+	require.NoError(t, err)
+	require.Len(t, settings, 1)
+	assert.Equal(t, "workitem", settings[0].EntryType)
+	assert.Equal(t, "custom_field_1", settings[0].CustomField)
+	assert.Equal(t, "预算", settings[0].Name)
+}
+
+func TestLiteService_GetWorkitemFieldsLabel(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/lite/workitems/get_fields_label", r.URL.Path)
+
+		assert.Equal(t, "22223333", r.URL.Query().Get("workspace_id"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/lite/get_workitem_fields_label.json"))
+	}))
+
+	labels, _, err := client.LiteService.GetWorkitemFieldsLabel(ctx, &GetLiteWorkitemFieldsLabelRequest{
+		WorkspaceID: Ptr(22223333),
+	})
+	require.NoError(t, err)
+	assert.Len(t, labels, 5)
+	assert.Equal(t, "标题", labels["name"])
+	assert.Equal(t, "分组", labels["group_id"])
+}
+
+func TestLiteService_CreateWorkitemRelation(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/lite/relations", r.URL.Path)
+
+		var req struct {
+			WorkspaceID int    `json:"workspace_id"`
+			WorkitemID  int64  `json:"workitem_id"`
+			TargetType  string `json:"target_type"`
+			TargetID    string `json:"target_id"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, 22223333, req.WorkspaceID)
+		assert.Equal(t, int64(1111112222001000601), req.WorkitemID)
+		assert.Equal(t, "story", req.TargetType)
+		assert.Equal(t, "1111112222001000641,1111112222001000642", req.TargetID)
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/lite/create_workitem_relation.json"))
+	}))
+
+	relations, _, err := client.LiteService.CreateWorkitemRelation(ctx, &CreateLiteWorkitemRelationRequest{
+		WorkspaceID: Ptr(22223333),
+		WorkitemID:  Ptr[int64](1111112222001000601),
+		TargetType:  Ptr(EntityTypeStory),
+		TargetID:    NewMulti[int64](1111112222001000641, 1111112222001000642),
+	})
+	require.NoError(t, err)
+	require.Len(t, relations, 2)
+	assert.Equal(t, EntityTypeStory, relations[0].TargetType)
+	assert.Equal(t, "1111112222001000642", relations[1].TargetID)
+}
+
+func TestLiteService_GetWorkitemRelatedStories(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/lite/workitems/get_related_stories", r.URL.Path)
+
+		assert.Equal(t, "22223333", r.URL.Query().Get("workspace_id"))
+		assert.Equal(t, "1111112222001000601", r.URL.Query().Get("workitem_id"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/lite/get_workitem_related_stories.json"))
+	}))
+
+	relations, _, err := client.LiteService.GetWorkitemRelatedStories(ctx, &GetLiteWorkitemRelatedStoriesRequest{
+		WorkspaceID: Ptr(22223333),
+		WorkitemID:  NewMulti[int64](1111112222001000601),
+	})
+	require.NoError(t, err)
+	require.Len(t, relations, 1)
+	assert.Equal(t, EntityTypeStory, relations[0].TargetType)
+	assert.Equal(t, "1111112222001000641", relations[0].TargetID)
+}
+
+func TestLiteService_GetWorkitemRelatedBugs(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/lite/workitems/get_related_bugs", r.URL.Path)
+
+		assert.Equal(t, "22223333", r.URL.Query().Get("workspace_id"))
+		assert.Equal(t, "1111112222001000601", r.URL.Query().Get("workitem_id"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/lite/get_workitem_related_bugs.json"))
+	}))
+
+	relations, _, err := client.LiteService.GetWorkitemRelatedBugs(ctx, &GetLiteWorkitemRelatedBugsRequest{
+		WorkspaceID: Ptr(22223333),
+		WorkitemID:  NewMulti[int64](1111112222001000601),
+	})
+	require.NoError(t, err)
+	require.Len(t, relations, 1)
+	assert.Equal(t, EntityTypeBug, relations[0].TargetType)
+	assert.Equal(t, "1111112222001000651", relations[0].TargetID)
+}
+
+func TestLiteService_RemoveWorkitemRelation(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/lite/relations/delete", r.URL.Path)
+
+		var req struct {
+			WorkspaceID int    `json:"workspace_id"`
+			WorkitemID  int64  `json:"workitem_id"`
+			TargetType  string `json:"target_type"`
+			TargetID    string `json:"target_id"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, 22223333, req.WorkspaceID)
+		assert.Equal(t, int64(1111112222001000601), req.WorkitemID)
+		assert.Equal(t, "bug", req.TargetType)
+		assert.Equal(t, "1111112222001000651", req.TargetID)
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/lite/remove_workitem_relation.json"))
+	}))
+
+	_, err := client.LiteService.RemoveWorkitemRelation(ctx, &RemoveLiteWorkitemRelationRequest{
+		WorkspaceID: Ptr(22223333),
+		WorkitemID:  Ptr[int64](1111112222001000601),
+		TargetType:  Ptr(EntityTypeBug),
+		TargetID:    NewMulti[int64](1111112222001000651),
+	})
+	assert.NoError(t, err)
+}
+
+func TestLiteService_GetRemovedWorkitems(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/lite/workitems/get_removed_workitems", r.URL.Path)
+
+		assert.Equal(t, "22223333", r.URL.Query().Get("workspace_id"))
+		assert.Equal(t, "alice", r.URL.Query().Get("creator"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/lite/get_removed_workitems.json"))
+	}))
+
+	workitems, _, err := client.LiteService.GetRemovedWorkitems(ctx, &GetLiteRemovedWorkitemsRequest{
+		WorkspaceID: Ptr(22223333),
+		Creator:     Ptr("alice"),
+	})
+	require.NoError(t, err)
+	require.Len(t, workitems, 1)
+	assert.Equal(t, "1111112222001000603", workitems[0].ID)
+	assert.Equal(t, "bob", workitems[0].OperationUser)
+	assert.Equal(t, "2025-03-01 12:00:00", workitems[0].Deleted)
+}
+
+func TestLiteService_GetSpaceInfo(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/lite/spaces/get_space_info", r.URL.Path)
+
+		assert.Equal(t, "22223333", r.URL.Query().Get("workspace_id"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/lite/get_space_info.json"))
+	}))
+
+	space, _, err := client.LiteService.GetSpaceInfo(ctx, &GetSpaceInfoRequest{
+		WorkspaceID: Ptr(22223333),
+	})
+	require.NoError(t, err)
+	require.NotNil(t, space)
+	assert.Equal(t, "22223333", space.ID)
+	assert.Equal(t, "市场部", space.Name)
+	assert.Equal(t, "normal", space.Status)
+}
+
+func TestLiteService_AddSpaceMember(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/lite/spaces/add_member", r.URL.Path)
+
+		var req struct {
+			WorkspaceID int    `json:"workspace_id"`
+			Nick        string `json:"nick"`
+			RoleID      string `json:"role_id"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, 22223333, req.WorkspaceID)
+		assert.Equal(t, "carol", req.Nick)
+		assert.Equal(t, "1111112222001000002", req.RoleID)
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/lite/add_space_member.json"))
+	}))
+
+	member, _, err := client.LiteService.AddSpaceMember(ctx, &AddSpaceMemberRequest{
+		WorkspaceID: Ptr(22223333),
+		Nick:        Ptr("carol"),
+		RoleID:      NewMulti[int64](1111112222001000002),
+	})
+	require.NoError(t, err)
+	require.NotNil(t, member)
+	assert.Equal(t, "carol", member.User)
+	assert.Equal(t, []string{"1111112222001000002"}, member.RoleId)
+}
+
+func TestLiteService_GetSpaceMembers(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/lite/spaces/users", r.URL.Path)
+
+		assert.Equal(t, "22223333", r.URL.Query().Get("workspace_id"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/lite/get_space_members.json"))
+	}))
+
+	members, _, err := client.LiteService.GetSpaceMembers(ctx, &GetSpaceMembersRequest{
+		WorkspaceID: Ptr(22223333),
+	})
+	require.NoError(t, err)
+	require.Len(t, members, 2)
+	assert.Equal(t, "alice", members[0].User)
+	assert.Equal(t, "carol@example.com", members[1].Email)
+}
+
+func TestLiteService_CreateSpace(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/lite/spaces", r.URL.Path)
+
+		var req struct {
+			CompanyID int    `json:"company_id"`
+			Name      string `json:"name"`
+			Members   string `json:"members"`
+			Creator   string `json:"creator"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, 1111111, req.CompanyID)
+		assert.Equal(t, "品牌活动", req.Name)
+		assert.Equal(t, "bob,carol", req.Members)
+		assert.Equal(t, "alice", req.Creator)
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/lite/create_space.json"))
+	}))
+
+	space, _, err := client.LiteService.CreateSpace(ctx, &CreateSpaceRequest{
+		CompanyID: Ptr(1111111),
+		Name:      Ptr("品牌活动"),
+		Members:   NewMulti("bob", "carol"),
+		Creator:   Ptr("alice"),
+	})
+	require.NoError(t, err)
+	require.NotNil(t, space)
+	assert.Equal(t, "22224444", space.ID)
+	assert.Equal(t, "品牌活动", space.Name)
+}
+
+func TestLiteService_GetUserSpaces(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/lite/spaces/user_participant_spaces", r.URL.Path)
+
+		assert.Equal(t, "alice", r.URL.Query().Get("nick"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/lite/get_user_spaces.json"))
+	}))
+
+	spaces, _, err := client.LiteService.GetUserSpaces(ctx, &GetUserSpacesRequest{
+		Nick: Ptr("alice"),
+	})
+	require.NoError(t, err)
+	require.Len(t, spaces, 2)
+	assert.Equal(t, "22223333", spaces[0].ID)
+	assert.Equal(t, "品牌活动", spaces[1].Name)
+}
+
+func TestLiteService_UploadAttachment(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/lite/attachments/upload", r.URL.Path)
+
+		require.NoError(t, r.ParseMultipartForm(1<<20))
+		assert.Equal(t, "22223333", r.FormValue("workspace_id"))
+		assert.Equal(t, "workitem", r.FormValue("type"))
+		assert.Equal(t, "1111112222001000601", r.FormValue("entry_id"))
+		assert.Equal(t, "alice", r.FormValue("owner"))
+
+		file, header, err := r.FormFile("file")
+		require.NoError(t, err)
+		defer file.Close() // nolint:errcheck
+		assert.Equal(t, "agenda.txt", header.Filename)
+		content, err := io.ReadAll(file)
+		require.NoError(t, err)
+		assert.Equal(t, "09:00 开场", string(content))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/lite/upload_attachment.json"))
+	}))
+
+	attachment, _, err := client.LiteService.UploadAttachment(ctx, &UploadLiteAttachmentRequest{
+		WorkspaceID: Ptr(22223333),
+		Type:        Ptr("workitem"),
+		EntryID:     Ptr[int64](1111112222001000601),
+		Owner:       Ptr("alice"),
+		Filename:    "agenda.txt",
+		File:        strings.NewReader("09:00 开场"),
+	})
+	require.NoError(t, err)
+	require.NotNil(t, attachment)
+	assert.Equal(t, "1111112222001000661", attachment.ID)
+	assert.Equal(t, "agenda.txt", attachment.Filename)
+	assert.Equal(t, "text/plain", attachment.ContentType)
+
+	_, _, err = client.LiteService.UploadAttachment(ctx, nil)
+	assert.Error(t, err)
+
+	_, _, err = client.LiteService.UploadAttachment(ctx, &UploadLiteAttachmentRequest{Filename: "agenda.txt"})
+	assert.Error(t, err)
+}
+
+func TestLiteService_UploadImage(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/lite/attachments/upload_base64_image", r.URL.Path)
+
+		var req struct {
+			WorkspaceID int    `json:"workspace_id"`
+			Base64Data  string `json:"base64_data"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, 22223333, req.WorkspaceID)
+		assert.Equal(t, "data:image/png;base64,iVBORw0KGgo=", req.Base64Data)
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/lite/upload_image.json"))
+	}))
+
+	image, _, err := client.LiteService.UploadImage(ctx, &UploadLiteImageRequest{
+		WorkspaceID: Ptr(22223333),
+		Base64Data:  Ptr("data:image/png;base64,iVBORw0KGgo="),
+	})
+	require.NoError(t, err)
+	require.NotNil(t, image)
+	assert.Equal(t, "/tfl/captures/2025-03/tapd_22223333_base64_1741680000_1.png", image.ImageSrc)
+	assert.Contains(t, image.HTMLCode, image.ImageSrc)
+}
+
+func TestLiteService_GetAttachmentDownloadURL(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/lite/attachments/down", r.URL.Path)
+
+		assert.Equal(t, "22223333", r.URL.Query().Get("workspace_id"))
+		assert.Equal(t, "1001", r.URL.Query().Get("id"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/lite/get_attachment_download_url.json"))
+	}))
+
+	attachment, _, err := client.LiteService.GetAttachmentDownloadURL(ctx, &GetAttachmentDownloadURLRequest{
+		WorkspaceID: Ptr(22223333),
+		ID:          Ptr(1001),
+	})
+	require.NoError(t, err)
+	require.NotNil(t, attachment)
+	assert.Equal(t, "https://file.tapd.cn/attachments/tmp_download/1111112222001000661", attachment.DownloadURL)
+}
+
+func TestLiteService_GetAttachments(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/lite/attachments", r.URL.Path)
+
+		assert.Equal(t, "22223333", r.URL.Query().Get("workspace_id"))
+		assert.Equal(t, "workitem", r.URL.Query().Get("type"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/lite/get_attachments.json"))
+	}))
+
+	attachments, _, err := client.LiteService.GetAttachments(ctx, &GetAttachmentsRequest{
+		WorkspaceID: Ptr(22223333),
+		Type:        Ptr("workitem"),
+	})
+	require.NoError(t, err)
+	require.Len(t, attachments, 1)
+	assert.Equal(t, "agenda.txt", attachments[0].Filename)
+	assert.Equal(t, "1111112222001000601", attachments[0].EntryID)
+}
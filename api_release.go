@@ -0,0 +1,553 @@
+package tapd
+
+import (
+	"context"
+	"net/http"
+)
+
+// ReleaseStatus 发布计划状态
+type ReleaseStatus string
+
+const (
+	ReleaseStatusOpen ReleaseStatus = "open" // 开启
+	ReleaseStatusDone ReleaseStatus = "done" // 已完成
+)
+
+// ReleaseReviewStatus 发布评审状态
+type ReleaseReviewStatus string
+
+const (
+	ReleaseReviewStatusReviewing ReleaseReviewStatus = "reviewing" // 评审中
+	ReleaseReviewStatusPass      ReleaseReviewStatus = "pass"      // 评审通过
+	ReleaseReviewStatusNoPass    ReleaseReviewStatus = "no_pass"   // 评审不通过
+)
+
+// Release 发布计划
+//
+// 需求、缺陷和迭代通过 release_id 关联到发布计划，如 Story.ReleaseID、Bug.ReleaseID 和 Iteration.ReleaseID
+type Release struct {
+	ID          string        `json:"id,omitempty"`           // ID
+	WorkspaceID string        `json:"workspace_id,omitempty"` // 项目ID
+	Name        string        `json:"name,omitempty"`         // 发布计划名称
+	Description string        `json:"description,omitempty"`  // 详细描述
+	StartDate   string        `json:"startdate,omitempty"`    // 开始时间
+	EndDate     string        `json:"enddate,omitempty"`      // 结束时间
+	Status      ReleaseStatus `json:"status,omitempty"`       // 状态
+	Creator     string        `json:"creator,omitempty"`      // 创建人
+	Created     string        `json:"created,omitempty"`      // 创建时间
+	Modified    string        `json:"modified,omitempty"`     // 最后修改时间
+}
+
+// ReleaseReview 发布评审
+type ReleaseReview struct {
+	ID          string              `json:"id,omitempty"`           // ID
+	WorkspaceID string              `json:"workspace_id,omitempty"` // 项目ID
+	ReleaseID   string              `json:"release_id,omitempty"`   // 发布计划ID
+	TemplateID  string              `json:"template_id,omitempty"`  // 评审模板ID
+	Name        string              `json:"name,omitempty"`         // 评审名称
+	Description string              `json:"description,omitempty"`  // 详细描述
+	Status      ReleaseReviewStatus `json:"status,omitempty"`       // 评审状态
+	Reviewers   string              `json:"reviewers,omitempty"`    // 评审人
+	Creator     string              `json:"creator,omitempty"`      // 创建人
+	Created     string              `json:"created,omitempty"`      // 创建时间
+	Modified    string              `json:"modified,omitempty"`     // 最后修改时间
+}
+
+// ReleaseReviewCriterion 发布评审依据
+type ReleaseReviewCriterion struct {
+	ID          string `json:"id,omitempty"`           // ID
+	WorkspaceID string `json:"workspace_id,omitempty"` // 项目ID
+	ReviewID    string `json:"review_id,omitempty"`    // 发布评审ID
+	Name        string `json:"name,omitempty"`         // 评审依据名称
+	Description string `json:"description,omitempty"`  // 详细描述
+	Result      string `json:"result,omitempty"`       // 评审结果
+	Creator     string `json:"creator,omitempty"`      // 创建人
+	Created     string `json:"created,omitempty"`      // 创建时间
+}
+
+// ReleaseReviewTemplate 发布评审模板
+type ReleaseReviewTemplate struct {
+	ID          string `json:"id,omitempty"`           // 模板ID
+	WorkspaceID string `json:"workspace_id,omitempty"` // 项目ID
+	Name        string `json:"name,omitempty"`         // 模板名称
+	Description string `json:"description,omitempty"`  // 详细描述
+	Default     string `json:"default,omitempty"`      // 是否默认模板
+	Creator     string `json:"creator,omitempty"`      // 创建人
+	Created     string `json:"created,omitempty"`      // 创建时间
+	Modified    string `json:"modified,omitempty"`     // 最后修改时间
+}
+
+// ReleaseReviewLog 发布评审日志
+type ReleaseReviewLog struct {
+	ID          string `json:"id,omitempty"`           // ID
+	WorkspaceID string `json:"workspace_id,omitempty"` // 项目ID
+	ReviewID    string `json:"review_id,omitempty"`    // 发布评审ID
+	Operator    string `json:"operator,omitempty"`     // 操作人
+	Action      string `json:"action,omitempty"`       // 操作类型
+	Content     string `json:"content,omitempty"`      // 日志内容
+	Created     string `json:"created,omitempty"`      // 操作时间
+}
+
+// ReleaseService 发布服务
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/release/
+type ReleaseService struct {
+	client *Client
+}
+
+// -----------------------------------------------------------------------------
+// 创建发布计划接口
+// -----------------------------------------------------------------------------
+
+type CreateReleaseRequest struct {
+	WorkspaceID *int           `json:"workspace_id,omitempty"` // [必须]项目ID
+	Name        *string        `json:"name,omitempty"`         // [必须]发布计划名称
+	StartDate   *string        `json:"startdate,omitempty"`    // [必须]开始时间
+	EndDate     *string        `json:"enddate,omitempty"`      // [必须]结束时间
+	Creator     *string        `json:"creator,omitempty"`      // [必须]创建人
+	Description *string        `json:"description,omitempty"`  // 详细描述
+	Status      *ReleaseStatus `json:"status,omitempty"`       // 状态
+}
+
+// CreateRelease 创建发布计划接口
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/release/add_release.html
+func (s *ReleaseService) CreateRelease(
+	ctx context.Context, request *CreateReleaseRequest, opts ...RequestOption,
+) (*Release, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "releases", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var item struct {
+		Release *Release `json:"Release"`
+	}
+	resp, err := s.client.Do(req, &item)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return item.Release, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 获取发布评审依据
+// -----------------------------------------------------------------------------
+
+type GetReleaseReviewCriteriaRequest struct {
+	WorkspaceID *int          `url:"workspace_id,omitempty"` // [必须]项目ID
+	ReviewID    *int64        `url:"review_id,omitempty"`    // 发布评审ID
+	ID          *Multi[int64] `url:"id,omitempty"`           // ID	支持多ID查询
+	Limit       *int          `url:"limit,omitempty"`        // 设置返回数量限制，默认为30
+	Page        *int          `url:"page,omitempty"`         // 返回当前数量限制下第N页的数据，默认为1（第一页）
+}
+
+// GetReleaseReviewCriteria 获取发布评审依据
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/release/get_release_review_criteria.html
+func (s *ReleaseService) GetReleaseReviewCriteria(
+	ctx context.Context, request *GetReleaseReviewCriteriaRequest, opts ...RequestOption,
+) ([]*ReleaseReviewCriterion, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "release_review_criteria", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var items []struct {
+		ReleaseReviewCriteria *ReleaseReviewCriterion `json:"ReleaseReviewCriteria"`
+	}
+	resp, err := s.client.Do(req, &items)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	criteria := make([]*ReleaseReviewCriterion, 0, len(items))
+	for _, item := range items {
+		criteria = append(criteria, item.ReleaseReviewCriteria)
+	}
+
+	return criteria, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 获取发布计划接口
+// -----------------------------------------------------------------------------
+
+type GetReleasesRequest struct {
+	WorkspaceID *int                 `url:"workspace_id,omitempty"` // [必须]项目ID
+	ID          *Multi[int64]        `url:"id,omitempty"`           // ID	支持多ID查询
+	Name        *string              `url:"name,omitempty"`         // 发布计划名称	支持模糊匹配
+	Description *string              `url:"description,omitempty"`  // 详细描述
+	StartDate   *TimeQuery           `url:"startdate,omitempty"`    // 开始时间	支持时间查询
+	EndDate     *TimeQuery           `url:"enddate,omitempty"`      // 结束时间	支持时间查询
+	Status      *Enum[ReleaseStatus] `url:"status,omitempty"`       // 状态	支持枚举查询
+	Creator     *string              `url:"creator,omitempty"`      // 创建人
+	Created     *TimeQuery           `url:"created,omitempty"`      // 创建时间	支持时间查询
+	Modified    *TimeQuery           `url:"modified,omitempty"`     // 最后修改时间	支持时间查询
+	Limit       *int                 `url:"limit,omitempty"`        // 设置返回数量限制，默认为30
+	Page        *int                 `url:"page,omitempty"`         // 返回当前数量限制下第N页的数据，默认为1（第一页）
+	Order       *Order               `url:"order,omitempty"`        // 排序规则，规则：字段名 ASC或者DESC
+	Fields      *Multi[string]       `url:"fields,omitempty"`       // 设置获取的字段，多个字段间以','逗号隔开
+}
+
+// GetReleases 获取发布计划接口
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/release/get_releases.html
+func (s *ReleaseService) GetReleases(
+	ctx context.Context, request *GetReleasesRequest, opts ...RequestOption,
+) ([]*Release, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "releases", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var items []struct {
+		Release *Release `json:"Release"`
+	}
+	resp, err := s.client.Do(req, &items)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	releases := make([]*Release, 0, len(items))
+	for _, item := range items {
+		releases = append(releases, item.Release)
+	}
+
+	return releases, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 获取发布计划数量接口
+// -----------------------------------------------------------------------------
+
+type GetReleasesCountRequest struct {
+	WorkspaceID *int                 `url:"workspace_id,omitempty"` // [必须]项目ID
+	ID          *Multi[int64]        `url:"id,omitempty"`           // ID	支持多ID查询
+	Name        *string              `url:"name,omitempty"`         // 发布计划名称	支持模糊匹配
+	Description *string              `url:"description,omitempty"`  // 详细描述
+	StartDate   *TimeQuery           `url:"startdate,omitempty"`    // 开始时间	支持时间查询
+	EndDate     *TimeQuery           `url:"enddate,omitempty"`      // 结束时间	支持时间查询
+	Status      *Enum[ReleaseStatus] `url:"status,omitempty"`       // 状态	支持枚举查询
+	Creator     *string              `url:"creator,omitempty"`      // 创建人
+	Created     *TimeQuery           `url:"created,omitempty"`      // 创建时间	支持时间查询
+	Modified    *TimeQuery           `url:"modified,omitempty"`     // 最后修改时间	支持时间查询
+}
+
+// GetReleasesCount 获取发布计划数量接口
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/release/get_releases_count.html
+func (s *ReleaseService) GetReleasesCount(
+	ctx context.Context, request *GetReleasesCountRequest, opts ...RequestOption,
+) (int, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "releases/count", request, opts)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var response CountResponse
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return 0, resp, err
+	}
+
+	return response.Count, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 获取发布评审
+// -----------------------------------------------------------------------------
+
+type GetReleaseReviewsRequest struct {
+	WorkspaceID *int                       `url:"workspace_id,omitempty"` // [必须]项目ID
+	ID          *Multi[int64]              `url:"id,omitempty"`           // ID	支持多ID查询
+	ReleaseID   *Multi[int64]              `url:"release_id,omitempty"`   // 发布计划ID	支持多ID查询
+	Name        *string                    `url:"name,omitempty"`         // 评审名称	支持模糊匹配
+	Status      *Enum[ReleaseReviewStatus] `url:"status,omitempty"`       // 评审状态	支持枚举查询
+	Creator     *string                    `url:"creator,omitempty"`      // 创建人
+	Created     *TimeQuery                 `url:"created,omitempty"`      // 创建时间	支持时间查询
+	Modified    *TimeQuery                 `url:"modified,omitempty"`     // 最后修改时间	支持时间查询
+	Limit       *int                       `url:"limit,omitempty"`        // 设置返回数量限制，默认为30
+	Page        *int                       `url:"page,omitempty"`         // 返回当前数量限制下第N页的数据，默认为1（第一页）
+	Order       *Order                     `url:"order,omitempty"`        // 排序规则，规则：字段名 ASC或者DESC
+	Fields      *Multi[string]             `url:"fields,omitempty"`       // 设置获取的字段，多个字段间以','逗号隔开
+}
+
+// GetReleaseReviews 获取发布评审
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/release/get_release_reviews.html
+func (s *ReleaseService) GetReleaseReviews(
+	ctx context.Context, request *GetReleaseReviewsRequest, opts ...RequestOption,
+) ([]*ReleaseReview, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "release_reviews", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var items []struct {
+		ReleaseReview *ReleaseReview `json:"ReleaseReview"`
+	}
+	resp, err := s.client.Do(req, &items)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	reviews := make([]*ReleaseReview, 0, len(items))
+	for _, item := range items {
+		reviews = append(reviews, item.ReleaseReview)
+	}
+
+	return reviews, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 更新发布计划接口
+// -----------------------------------------------------------------------------
+
+type UpdateReleaseRequest struct {
+	WorkspaceID *int           `json:"workspace_id,omitempty"` // [必须]项目ID
+	ID          *int64         `json:"id,omitempty"`           // [必须]ID
+	Name        *string        `json:"name,omitempty"`         // 发布计划名称
+	StartDate   *string        `json:"startdate,omitempty"`    // 开始时间
+	EndDate     *string        `json:"enddate,omitempty"`      // 结束时间
+	Description *string        `json:"description,omitempty"`  // 详细描述
+	Status      *ReleaseStatus `json:"status,omitempty"`       // 状态
+	CurrentUser *string        `json:"current_user,omitempty"` // 变更人
+}
+
+// UpdateRelease 更新发布计划接口
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/release/update_release.html
+func (s *ReleaseService) UpdateRelease(
+	ctx context.Context, request *UpdateReleaseRequest, opts ...RequestOption,
+) (*Release, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "releases", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var item struct {
+		Release *Release `json:"Release"`
+	}
+	resp, err := s.client.Do(req, &item)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return item.Release, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 创建发布评审
+// -----------------------------------------------------------------------------
+
+type CreateReleaseReviewRequest struct {
+	WorkspaceID *int    `json:"workspace_id,omitempty"` // [必须]项目ID
+	ReleaseID   *int64  `json:"release_id,omitempty"`   // [必须]发布计划ID
+	Name        *string `json:"name,omitempty"`         // [必须]评审名称
+	TemplateID  *int64  `json:"template_id,omitempty"`  // 评审模板ID
+	Description *string `json:"description,omitempty"`  // 详细描述
+	Reviewers   *string `json:"reviewers,omitempty"`    // 评审人，多个以英文分号分隔
+	Creator     *string `json:"creator,omitempty"`      // 创建人
+}
+
+// CreateReleaseReview 创建发布评审
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/release/add_release_review.html
+func (s *ReleaseService) CreateReleaseReview(
+	ctx context.Context, request *CreateReleaseReviewRequest, opts ...RequestOption,
+) (*ReleaseReview, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "release_reviews", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var item struct {
+		ReleaseReview *ReleaseReview `json:"ReleaseReview"`
+	}
+	resp, err := s.client.Do(req, &item)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return item.ReleaseReview, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 创建发布评审依据
+// -----------------------------------------------------------------------------
+
+type CreateReleaseReviewCriterionRequest struct {
+	WorkspaceID *int    `json:"workspace_id,omitempty"` // [必须]项目ID
+	ReviewID    *int64  `json:"review_id,omitempty"`    // [必须]发布评审ID
+	Name        *string `json:"name,omitempty"`         // [必须]评审依据名称
+	Description *string `json:"description,omitempty"`  // 详细描述
+	Creator     *string `json:"creator,omitempty"`      // 创建人
+}
+
+// CreateReleaseReviewCriterion 创建发布评审依据
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/release/add_release_review_criteria.html
+func (s *ReleaseService) CreateReleaseReviewCriterion(
+	ctx context.Context, request *CreateReleaseReviewCriterionRequest, opts ...RequestOption,
+) (*ReleaseReviewCriterion, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "release_review_criteria", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var item struct {
+		ReleaseReviewCriteria *ReleaseReviewCriterion `json:"ReleaseReviewCriteria"`
+	}
+	resp, err := s.client.Do(req, &item)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return item.ReleaseReviewCriteria, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 获取发布评审数量接口
+// -----------------------------------------------------------------------------
+
+type GetReleaseReviewsCountRequest struct {
+	WorkspaceID *int                       `url:"workspace_id,omitempty"` // [必须]项目ID
+	ID          *Multi[int64]              `url:"id,omitempty"`           // ID	支持多ID查询
+	ReleaseID   *Multi[int64]              `url:"release_id,omitempty"`   // 发布计划ID	支持多ID查询
+	Name        *string                    `url:"name,omitempty"`         // 评审名称	支持模糊匹配
+	Status      *Enum[ReleaseReviewStatus] `url:"status,omitempty"`       // 评审状态	支持枚举查询
+	Creator     *string                    `url:"creator,omitempty"`      // 创建人
+	Created     *TimeQuery                 `url:"created,omitempty"`      // 创建时间	支持时间查询
+	Modified    *TimeQuery                 `url:"modified,omitempty"`     // 最后修改时间	支持时间查询
+}
+
+// GetReleaseReviewsCount 获取发布评审数量接口
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/release/get_release_reviews_count.html
+func (s *ReleaseService) GetReleaseReviewsCount(
+	ctx context.Context, request *GetReleaseReviewsCountRequest, opts ...RequestOption,
+) (int, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "release_reviews/count", request, opts)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var response CountResponse
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return 0, resp, err
+	}
+
+	return response.Count, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 获取发布评审自定义字段
+// -----------------------------------------------------------------------------
+
+type GetReleaseReviewCustomFieldsSettingsRequest struct {
+	WorkspaceID *int `url:"workspace_id,omitempty"` // [必须]项目ID
+}
+
+// GetReleaseReviewCustomFieldsSettings 获取发布评审自定义字段
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/release/get_release_review_custom_fields_settings.html
+func (s *ReleaseService) GetReleaseReviewCustomFieldsSettings(
+	ctx context.Context, request *GetReleaseReviewCustomFieldsSettingsRequest, opts ...RequestOption,
+) ([]*CustomFieldsSetting, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "release_reviews/custom_fields_settings", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var items []struct {
+		CustomFieldConfig *CustomFieldsSetting `json:"CustomFieldConfig"`
+	}
+	resp, err := s.client.Do(req, &items)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	settings := make([]*CustomFieldsSetting, 0, len(items))
+	for _, item := range items {
+		settings = append(settings, item.CustomFieldConfig)
+	}
+
+	return settings, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 获取发布评审模板
+// -----------------------------------------------------------------------------
+
+type GetReleaseReviewTemplatesRequest struct {
+	WorkspaceID *int `url:"workspace_id,omitempty"` // [必须]项目ID
+}
+
+// GetReleaseReviewTemplates 获取发布评审模板
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/release/get_release_review_templates.html
+func (s *ReleaseService) GetReleaseReviewTemplates(
+	ctx context.Context, request *GetReleaseReviewTemplatesRequest, opts ...RequestOption,
+) ([]*ReleaseReviewTemplate, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "release_reviews/template_list", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var items []struct {
+		ReleaseReviewTemplate *ReleaseReviewTemplate `json:"ReleaseReviewTemplate"`
+	}
+	resp, err := s.client.Do(req, &items)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	templates := make([]*ReleaseReviewTemplate, 0, len(items))
+	for _, item := range items {
+		templates = append(templates, item.ReleaseReviewTemplate)
+	}
+
+	return templates, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 获取发布评审日志
+// -----------------------------------------------------------------------------
+
+type GetReleaseReviewLogsRequest struct {
+	WorkspaceID *int   `url:"workspace_id,omitempty"` // [必须]项目ID
+	ReviewID    *int64 `url:"review_id,omitempty"`    // [必须]发布评审ID
+	Limit       *int   `url:"limit,omitempty"`        // 设置返回数量限制，默认为30
+	Page        *int   `url:"page,omitempty"`         // 返回当前数量限制下第N页的数据，默认为1（第一页）
+	Order       *Order `url:"order,omitempty"`        // 排序规则，规则：字段名 ASC或者DESC
+}
+
+// GetReleaseReviewLogs 获取发布评审日志
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/release/get_release_review_logs.html
+func (s *ReleaseService) GetReleaseReviewLogs(
+	ctx context.Context, request *GetReleaseReviewLogsRequest, opts ...RequestOption,
+) ([]*ReleaseReviewLog, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "release_reviews/logs", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var items []struct {
+		ReleaseReviewLog *ReleaseReviewLog `json:"ReleaseReviewLog"`
+	}
+	resp, err := s.client.Do(req, &items)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	logs := make([]*ReleaseReviewLog, 0, len(items))
+	for _, item := range items {
+		logs = append(logs, item.ReleaseReviewLog)
+	}
+
+	return logs, resp, nil
+}
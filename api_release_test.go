@@ -0,0 +1,300 @@
+package tapd
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReleaseService_CreateRelease(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/releases", r.URL.Path)
+
+		var req struct {
+			WorkspaceID int    `json:"workspace_id"`
+			Name        string `json:"name"`
+			StartDate   string `json:"startdate"`
+			EndDate     string `json:"enddate"`
+			Creator     string `json:"creator"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, 11112222, req.WorkspaceID)
+		assert.Equal(t, "2025.01 版本", req.Name)
+		assert.Equal(t, "2025-01-01", req.StartDate)
+		assert.Equal(t, "2025-01-20", req.EndDate)
+		assert.Equal(t, "张三", req.Creator)
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/release/create_release.json"))
+	}))
+
+	release, _, err := client.ReleaseService.CreateRelease(ctx, &CreateReleaseRequest{
+		WorkspaceID: Ptr(11112222),
+		Name:        Ptr("2025.01 版本"),
+		StartDate:   Ptr("2025-01-01"),
+		EndDate:     Ptr("2025-01-20"),
+		Creator:     Ptr("张三"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "1111112222001000041", release.ID)
+	assert.Equal(t, "11112222", release.WorkspaceID)
+	assert.Equal(t, "2025.01 版本", release.Name)
+	assert.Equal(t, "一月版本发布", release.Description)
+	assert.Equal(t, "2025-01-01", release.StartDate)
+	assert.Equal(t, "2025-01-20", release.EndDate)
+	assert.Equal(t, ReleaseStatusOpen, release.Status)
+}
+
+func TestReleaseService_GetReleaseReviewCriteria(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/release_review_criteria", r.URL.Path)
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+		assert.Equal(t, "1111112222001000051", r.URL.Query().Get("review_id"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/release/get_release_review_criteria.json"))
+	}))
+
+	criteria, _, err := client.ReleaseService.GetReleaseReviewCriteria(ctx, &GetReleaseReviewCriteriaRequest{
+		WorkspaceID: Ptr(11112222),
+		ReviewID:    Ptr[int64](1111112222001000051),
+	})
+	require.NoError(t, err)
+	require.Len(t, criteria, 2)
+	assert.Equal(t, "1111112222001000071", criteria[0].ID)
+	assert.Equal(t, "1111112222001000051", criteria[0].ReviewID)
+	assert.Equal(t, "所有致命缺陷已关闭", criteria[0].Name)
+	assert.Equal(t, "pass", criteria[0].Result)
+	assert.Equal(t, "no_pass", criteria[1].Result)
+}
+
+func TestReleaseService_GetReleases(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/releases", r.URL.Path)
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+		assert.Equal(t, "open", r.URL.Query().Get("status"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/release/get_releases.json"))
+	}))
+
+	releases, _, err := client.ReleaseService.GetReleases(ctx, &GetReleasesRequest{
+		WorkspaceID: Ptr(11112222),
+		Status:      NewEnum(ReleaseStatusOpen),
+	})
+	require.NoError(t, err)
+	require.Len(t, releases, 2)
+	assert.Equal(t, "1111112222001000041", releases[0].ID)
+	assert.Equal(t, "1111112222001000042", releases[1].ID)
+	assert.Equal(t, "2025.02 版本", releases[1].Name)
+}
+
+func TestReleaseService_GetReleasesCount(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/releases/count", r.URL.Path)
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/release/get_releases_count.json"))
+	}))
+
+	count, _, err := client.ReleaseService.GetReleasesCount(ctx, &GetReleasesCountRequest{
+		WorkspaceID: Ptr(11112222),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+}
+
+func TestReleaseService_GetReleaseReviews(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/release_reviews", r.URL.Path)
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+		assert.Equal(t, "1111112222001000041", r.URL.Query().Get("release_id"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/release/get_release_reviews.json"))
+	}))
+
+	reviews, _, err := client.ReleaseService.GetReleaseReviews(ctx, &GetReleaseReviewsRequest{
+		WorkspaceID: Ptr(11112222),
+		ReleaseID:   NewMulti[int64](1111112222001000041),
+	})
+	require.NoError(t, err)
+	require.Len(t, reviews, 1)
+	assert.Equal(t, "1111112222001000051", reviews[0].ID)
+	assert.Equal(t, "1111112222001000041", reviews[0].ReleaseID)
+	assert.Equal(t, "1111112222001000061", reviews[0].TemplateID)
+	assert.Equal(t, ReleaseReviewStatusPass, reviews[0].Status)
+	assert.Equal(t, "张三;李四;", reviews[0].Reviewers)
+}
+
+func TestReleaseService_UpdateRelease(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/releases", r.URL.Path)
+
+		var req struct {
+			WorkspaceID int           `json:"workspace_id"`
+			ID          int64         `json:"id"`
+			Status      ReleaseStatus `json:"status"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, 11112222, req.WorkspaceID)
+		assert.Equal(t, int64(1111112222001000041), req.ID)
+		assert.Equal(t, ReleaseStatusDone, req.Status)
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/release/update_release.json"))
+	}))
+
+	release, _, err := client.ReleaseService.UpdateRelease(ctx, &UpdateReleaseRequest{
+		WorkspaceID: Ptr(11112222),
+		ID:          Ptr[int64](1111112222001000041),
+		Status:      Ptr(ReleaseStatusDone),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "1111112222001000041", release.ID)
+	assert.Equal(t, ReleaseStatusDone, release.Status)
+	assert.Equal(t, "2025-01-20 18:00:00", release.Modified)
+}
+
+func TestReleaseService_CreateReleaseReview(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/release_reviews", r.URL.Path)
+
+		var req struct {
+			WorkspaceID int    `json:"workspace_id"`
+			ReleaseID   int64  `json:"release_id"`
+			Name        string `json:"name"`
+			Reviewers   string `json:"reviewers"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, 11112222, req.WorkspaceID)
+		assert.Equal(t, int64(1111112222001000041), req.ReleaseID)
+		assert.Equal(t, "2025.01 发布评审", req.Name)
+		assert.Equal(t, "张三;李四;", req.Reviewers)
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/release/create_release_review.json"))
+	}))
+
+	review, _, err := client.ReleaseService.CreateReleaseReview(ctx, &CreateReleaseReviewRequest{
+		WorkspaceID: Ptr(11112222),
+		ReleaseID:   Ptr[int64](1111112222001000041),
+		Name:        Ptr("2025.01 发布评审"),
+		Reviewers:   Ptr("张三;李四;"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "1111112222001000051", review.ID)
+	assert.Equal(t, "1111112222001000041", review.ReleaseID)
+	assert.Equal(t, ReleaseReviewStatusReviewing, review.Status)
+}
+
+func TestReleaseService_CreateReleaseReviewCriterion(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/release_review_criteria", r.URL.Path)
+
+		var req struct {
+			WorkspaceID int    `json:"workspace_id"`
+			ReviewID    int64  `json:"review_id"`
+			Name        string `json:"name"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, 11112222, req.WorkspaceID)
+		assert.Equal(t, int64(1111112222001000051), req.ReviewID)
+		assert.Equal(t, "所有致命缺陷已关闭", req.Name)
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/release/create_release_review_criterion.json"))
+	}))
+
+	criterion, _, err := client.ReleaseService.CreateReleaseReviewCriterion(ctx, &CreateReleaseReviewCriterionRequest{
+		WorkspaceID: Ptr(11112222),
+		ReviewID:    Ptr[int64](1111112222001000051),
+		Name:        Ptr("所有致命缺陷已关闭"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "1111112222001000071", criterion.ID)
+	assert.Equal(t, "1111112222001000051", criterion.ReviewID)
+	assert.Equal(t, "", criterion.Result)
+}
+
+func TestReleaseService_GetReleaseReviewsCount(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/release_reviews/count", r.URL.Path)
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+		assert.Equal(t, "reviewing|pass", r.URL.Query().Get("status"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/release/get_release_reviews_count.json"))
+	}))
+
+	count, _, err := client.ReleaseService.GetReleaseReviewsCount(ctx, &GetReleaseReviewsCountRequest{
+		WorkspaceID: Ptr(11112222),
+		Status:      NewEnum(ReleaseReviewStatusReviewing, ReleaseReviewStatusPass),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+}
+
+func TestReleaseService_GetReleaseReviewCustomFieldsSettings(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/release_reviews/custom_fields_settings", r.URL.Path)
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/release/get_release_review_custom_fields_settings.json"))
+	}))
+
+	settings, _, err := client.ReleaseService.GetReleaseReviewCustomFieldsSettings(ctx, &GetReleaseReviewCustomFieldsSettingsRequest{
+		WorkspaceID: Ptr(11112222),
+	})
+	require.NoError(t, err)
+	require.Len(t, settings, 1)
+	assert.Equal(t, "1111112222001000355", settings[0].ID)
+	assert.Equal(t, "release_review", settings[0].EntryType)
+	assert.Equal(t, "custom_field_1", settings[0].CustomField)
+	assert.Equal(t, "变更单号", settings[0].Name)
+}
+
+func TestReleaseService_GetReleaseReviewTemplates(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/release_reviews/template_list", r.URL.Path)
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/release/get_release_review_templates.json"))
+	}))
+
+	templates, _, err := client.ReleaseService.GetReleaseReviewTemplates(ctx, &GetReleaseReviewTemplatesRequest{
+		WorkspaceID: Ptr(11112222),
+	})
+	require.NoError(t, err)
+	require.Len(t, templates, 1)
+	assert.Equal(t, "1111112222001000061", templates[0].ID)
+	assert.Equal(t, "默认发布评审模板", templates[0].Name)
+	assert.Equal(t, "1", templates[0].Default)
+}
+
+func TestReleaseService_GetReleaseReviewLogs(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/release_reviews/logs", r.URL.Path)
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+		assert.Equal(t, "1111112222001000051", r.URL.Query().Get("review_id"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/release/get_release_review_logs.json"))
+	}))
+
+	logs, _, err := client.ReleaseService.GetReleaseReviewLogs(ctx, &GetReleaseReviewLogsRequest{
+		WorkspaceID: Ptr(11112222),
+		ReviewID:    Ptr[int64](1111112222001000051),
+	})
+	require.NoError(t, err)
+	require.Len(t, logs, 2)
+	assert.Equal(t, "张三", logs[0].Operator)
+	assert.Equal(t, "create", logs[0].Action)
+	assert.Equal(t, "评审通过", logs[1].Content)
+}
@@ -5,6 +5,66 @@ import (
 	"net/http"
 )
 
+// Module 模块
+type Module struct {
+	ID          string `json:"id,omitempty"`           // ID
+	WorkspaceID string `json:"workspace_id,omitempty"` // 项目ID
+	Name        string `json:"name,omitempty"`         // 模块名称
+	Description string `json:"description,omitempty"`  // 详细描述
+	Owner       string `json:"owner,omitempty"`        // 负责人
+	Creator     string `json:"creator,omitempty"`      // 创建人
+	Modifier    string `json:"modifier,omitempty"`     // 最后修改人
+	Created     string `json:"created,omitempty"`      // 创建时间
+	Modified    string `json:"modified,omitempty"`     // 最后修改时间
+}
+
+// ProductVersion 版本
+type ProductVersion struct {
+	ID          string `json:"id,omitempty"`           // ID
+	WorkspaceID string `json:"workspace_id,omitempty"` // 项目ID
+	Name        string `json:"name,omitempty"`         // 版本名称
+	Description string `json:"description,omitempty"`  // 详细描述
+	Status      string `json:"status,omitempty"`       // 状态
+	Creator     string `json:"creator,omitempty"`      // 创建人
+	Modifier    string `json:"modifier,omitempty"`     // 最后修改人
+	Created     string `json:"created,omitempty"`      // 创建时间
+	Modified    string `json:"modified,omitempty"`     // 最后修改时间
+}
+
+// Baseline 基线
+type Baseline struct {
+	ID          string `json:"id,omitempty"`           // ID
+	WorkspaceID string `json:"workspace_id,omitempty"` // 项目ID
+	Name        string `json:"name,omitempty"`         // 基线名称
+	Description string `json:"description,omitempty"`  // 详细描述
+	Creator     string `json:"creator,omitempty"`      // 创建人
+	Modifier    string `json:"modifier,omitempty"`     // 最后修改人
+	Created     string `json:"created,omitempty"`      // 创建时间
+	Modified    string `json:"modified,omitempty"`     // 最后修改时间
+}
+
+// Feature 特性
+type Feature struct {
+	ID          string `json:"id,omitempty"`           // ID
+	WorkspaceID string `json:"workspace_id,omitempty"` // 项目ID
+	Name        string `json:"name,omitempty"`         // 特性名称
+	Description string `json:"description,omitempty"`  // 详细描述
+	Status      string `json:"status,omitempty"`       // 状态
+	Creator     string `json:"creator,omitempty"`      // 创建人
+	Modifier    string `json:"modifier,omitempty"`     // 最后修改人
+	Created     string `json:"created,omitempty"`      // 创建时间
+	Modified    string `json:"modified,omitempty"`     // 最后修改时间
+}
+
+// CustomFieldsSetting 自定义字段配置，与需求自定义字段配置结构一致
+type CustomFieldsSetting = StoryCustomFieldsSetting
+
+// CascadeOption 级联自定义字段候选值
+type CascadeOption struct {
+	Value    string           `json:"value"`              // 候选值
+	Children []*CascadeOption `json:"children,omitempty"` // 下一级候选值
+}
+
 // SettingService 配置
 //
 // https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/setting/
@@ -13,28 +73,8 @@ type SettingService struct {
 }
 
 // 创建自定义字段（需求及缺陷）
-// 更新下拉类型自定义字段候选值
-// 更新需求下拉类型自定义字段候选值
-// 更新缺陷下拉类型自定义字段候选值
-// 更新级联自定义字段侯选值
-// 创建模块接口
-// 创建版本接口
-// 获取模块接口
-// 获取模块数量接口
-// 获取版本接口
-// 获取版本数量接口
-// 更新模块接口
-// 创建基线接口
-// 创建特性接口
 // 复制需求类别接口
 // 复制缺陷配置接口
-// 更新基线接口
-// 更新特性接口
-// 获取特性接口
-// 获取特性数量接口
-// 获取基线接口
-// 获取基线数量接口
-// 更新版本接口
 
 type GetWorkspaceSettingRequest struct {
 	WorkspaceID *int    `url:"workspace_id,omitempty"` // 项目ID
@@ -65,3 +105,733 @@ func (s *SettingService) GetWorkspaceSetting(
 
 	return response, resp, nil
 }
+
+// -----------------------------------------------------------------------------
+// 创建模块接口
+// -----------------------------------------------------------------------------
+
+type CreateModuleRequest struct {
+	WorkspaceID *int    `json:"workspace_id,omitempty"` // [必须]项目ID
+	Name        *string `json:"name,omitempty"`         // [必须]模块名称
+	Description *string `json:"description,omitempty"`  // 详细描述
+	Owner       *string `json:"owner,omitempty"`        // 负责人
+	Creator     *string `json:"creator,omitempty"`      // 创建人
+}
+
+// CreateModule 创建模块接口
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/setting/add_module.html
+func (s *SettingService) CreateModule(
+	ctx context.Context, request *CreateModuleRequest, opts ...RequestOption,
+) (*Module, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "modules", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var item struct {
+		Module *Module `json:"Module"`
+	}
+	resp, err := s.client.Do(req, &item)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return item.Module, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 获取模块接口
+// -----------------------------------------------------------------------------
+
+type GetModulesRequest struct {
+	WorkspaceID *int           `url:"workspace_id,omitempty"` // [必须]项目ID
+	ID          *Multi[int64]  `url:"id,omitempty"`           // ID	支持多ID查询
+	Name        *string        `url:"name,omitempty"`         // 模块名称	支持模糊匹配
+	Description *string        `url:"description,omitempty"`  // 详细描述
+	Owner       *string        `url:"owner,omitempty"`        // 负责人
+	Creator     *string        `url:"creator,omitempty"`      // 创建人
+	Created     *TimeQuery     `url:"created,omitempty"`      // 创建时间	支持时间查询
+	Modified    *TimeQuery     `url:"modified,omitempty"`     // 最后修改时间	支持时间查询
+	Limit       *int           `url:"limit,omitempty"`        // 设置返回数量限制，默认为30
+	Page        *int           `url:"page,omitempty"`         // 返回当前数量限制下第N页的数据，默认为1（第一页）
+	Order       *Order         `url:"order,omitempty"`        // 排序规则，规则：字段名 ASC或者DESC
+	Fields      *Multi[string] `url:"fields,omitempty"`       // 设置获取的字段，多个字段间以','逗号隔开
+}
+
+// GetModules 获取模块接口
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/setting/get_modules.html
+func (s *SettingService) GetModules(
+	ctx context.Context, request *GetModulesRequest, opts ...RequestOption,
+) ([]*Module, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "modules", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var items []struct {
+		Module *Module `json:"Module"`
+	}
+	resp, err := s.client.Do(req, &items)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	modules := make([]*Module, 0, len(items))
+	for _, item := range items {
+		modules = append(modules, item.Module)
+	}
+
+	return modules, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 获取模块数量接口
+// -----------------------------------------------------------------------------
+
+type GetModulesCountRequest struct {
+	WorkspaceID *int          `url:"workspace_id,omitempty"` // [必须]项目ID
+	ID          *Multi[int64] `url:"id,omitempty"`           // ID	支持多ID查询
+	Name        *string       `url:"name,omitempty"`         // 模块名称	支持模糊匹配
+	Description *string       `url:"description,omitempty"`  // 详细描述
+	Owner       *string       `url:"owner,omitempty"`        // 负责人
+	Creator     *string       `url:"creator,omitempty"`      // 创建人
+	Created     *TimeQuery    `url:"created,omitempty"`      // 创建时间	支持时间查询
+	Modified    *TimeQuery    `url:"modified,omitempty"`     // 最后修改时间	支持时间查询
+}
+
+// GetModulesCount 获取模块数量接口
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/setting/get_modules_count.html
+func (s *SettingService) GetModulesCount(
+	ctx context.Context, request *GetModulesCountRequest, opts ...RequestOption,
+) (int, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "modules/count", request, opts)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var response CountResponse
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return 0, resp, err
+	}
+
+	return response.Count, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 更新模块接口
+// -----------------------------------------------------------------------------
+
+type UpdateModuleRequest struct {
+	WorkspaceID *int    `json:"workspace_id,omitempty"` // [必须]项目ID
+	ID          *int64  `json:"id,omitempty"`           // [必须]ID
+	Name        *string `json:"name,omitempty"`         // 模块名称
+	Description *string `json:"description,omitempty"`  // 详细描述
+	Owner       *string `json:"owner,omitempty"`        // 负责人
+	Modifier    *string `json:"modifier,omitempty"`     // 最后修改人
+}
+
+// UpdateModule 更新模块接口
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/setting/update_module.html
+func (s *SettingService) UpdateModule(
+	ctx context.Context, request *UpdateModuleRequest, opts ...RequestOption,
+) (*Module, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "modules", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var item struct {
+		Module *Module `json:"Module"`
+	}
+	resp, err := s.client.Do(req, &item)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return item.Module, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 创建版本接口
+// -----------------------------------------------------------------------------
+
+type CreateVersionRequest struct {
+	WorkspaceID *int    `json:"workspace_id,omitempty"` // [必须]项目ID
+	Name        *string `json:"name,omitempty"`         // [必须]版本名称
+	Description *string `json:"description,omitempty"`  // 详细描述
+	Status      *string `json:"status,omitempty"`       // 状态
+	Creator     *string `json:"creator,omitempty"`      // 创建人
+}
+
+// CreateVersion 创建版本接口
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/setting/add_version.html
+func (s *SettingService) CreateVersion(
+	ctx context.Context, request *CreateVersionRequest, opts ...RequestOption,
+) (*ProductVersion, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "versions", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var item struct {
+		Version *ProductVersion `json:"Version"`
+	}
+	resp, err := s.client.Do(req, &item)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return item.Version, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 获取版本接口
+// -----------------------------------------------------------------------------
+
+type GetVersionsRequest struct {
+	WorkspaceID *int           `url:"workspace_id,omitempty"` // [必须]项目ID
+	ID          *Multi[int64]  `url:"id,omitempty"`           // ID	支持多ID查询
+	Name        *string        `url:"name,omitempty"`         // 版本名称	支持模糊匹配
+	Description *string        `url:"description,omitempty"`  // 详细描述
+	Status      *string        `url:"status,omitempty"`       // 状态
+	Creator     *string        `url:"creator,omitempty"`      // 创建人
+	Created     *TimeQuery     `url:"created,omitempty"`      // 创建时间	支持时间查询
+	Modified    *TimeQuery     `url:"modified,omitempty"`     // 最后修改时间	支持时间查询
+	Limit       *int           `url:"limit,omitempty"`        // 设置返回数量限制，默认为30
+	Page        *int           `url:"page,omitempty"`         // 返回当前数量限制下第N页的数据，默认为1（第一页）
+	Order       *Order         `url:"order,omitempty"`        // 排序规则，规则：字段名 ASC或者DESC
+	Fields      *Multi[string] `url:"fields,omitempty"`       // 设置获取的字段，多个字段间以','逗号隔开
+}
+
+// GetVersions 获取版本接口
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/setting/get_versions.html
+func (s *SettingService) GetVersions(
+	ctx context.Context, request *GetVersionsRequest, opts ...RequestOption,
+) ([]*ProductVersion, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "versions", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var items []struct {
+		Version *ProductVersion `json:"Version"`
+	}
+	resp, err := s.client.Do(req, &items)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	versions := make([]*ProductVersion, 0, len(items))
+	for _, item := range items {
+		versions = append(versions, item.Version)
+	}
+
+	return versions, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 获取版本数量接口
+// -----------------------------------------------------------------------------
+
+type GetVersionsCountRequest struct {
+	WorkspaceID *int          `url:"workspace_id,omitempty"` // [必须]项目ID
+	ID          *Multi[int64] `url:"id,omitempty"`           // ID	支持多ID查询
+	Name        *string       `url:"name,omitempty"`         // 版本名称	支持模糊匹配
+	Description *string       `url:"description,omitempty"`  // 详细描述
+	Status      *string       `url:"status,omitempty"`       // 状态
+	Creator     *string       `url:"creator,omitempty"`      // 创建人
+	Created     *TimeQuery    `url:"created,omitempty"`      // 创建时间	支持时间查询
+	Modified    *TimeQuery    `url:"modified,omitempty"`     // 最后修改时间	支持时间查询
+}
+
+// GetVersionsCount 获取版本数量接口
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/setting/get_versions_count.html
+func (s *SettingService) GetVersionsCount(
+	ctx context.Context, request *GetVersionsCountRequest, opts ...RequestOption,
+) (int, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "versions/count", request, opts)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var response CountResponse
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return 0, resp, err
+	}
+
+	return response.Count, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 更新版本接口
+// -----------------------------------------------------------------------------
+
+type UpdateVersionRequest struct {
+	WorkspaceID *int    `json:"workspace_id,omitempty"` // [必须]项目ID
+	ID          *int64  `json:"id,omitempty"`           // [必须]ID
+	Name        *string `json:"name,omitempty"`         // 版本名称
+	Description *string `json:"description,omitempty"`  // 详细描述
+	Status      *string `json:"status,omitempty"`       // 状态
+	Modifier    *string `json:"modifier,omitempty"`     // 最后修改人
+}
+
+// UpdateVersion 更新版本接口
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/setting/update_version.html
+func (s *SettingService) UpdateVersion(
+	ctx context.Context, request *UpdateVersionRequest, opts ...RequestOption,
+) (*ProductVersion, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "versions", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var item struct {
+		Version *ProductVersion `json:"Version"`
+	}
+	resp, err := s.client.Do(req, &item)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return item.Version, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 创建基线接口
+// -----------------------------------------------------------------------------
+
+type CreateBaselineRequest struct {
+	WorkspaceID *int    `json:"workspace_id,omitempty"` // [必须]项目ID
+	Name        *string `json:"name,omitempty"`         // [必须]基线名称
+	Description *string `json:"description,omitempty"`  // 详细描述
+	Creator     *string `json:"creator,omitempty"`      // 创建人
+}
+
+// CreateBaseline 创建基线接口
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/setting/add_baseline.html
+func (s *SettingService) CreateBaseline(
+	ctx context.Context, request *CreateBaselineRequest, opts ...RequestOption,
+) (*Baseline, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "baselines", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var item struct {
+		Baseline *Baseline `json:"Baseline"`
+	}
+	resp, err := s.client.Do(req, &item)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return item.Baseline, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 获取基线接口
+// -----------------------------------------------------------------------------
+
+type GetBaselinesRequest struct {
+	WorkspaceID *int           `url:"workspace_id,omitempty"` // [必须]项目ID
+	ID          *Multi[int64]  `url:"id,omitempty"`           // ID	支持多ID查询
+	Name        *string        `url:"name,omitempty"`         // 基线名称	支持模糊匹配
+	Description *string        `url:"description,omitempty"`  // 详细描述
+	Creator     *string        `url:"creator,omitempty"`      // 创建人
+	Created     *TimeQuery     `url:"created,omitempty"`      // 创建时间	支持时间查询
+	Modified    *TimeQuery     `url:"modified,omitempty"`     // 最后修改时间	支持时间查询
+	Limit       *int           `url:"limit,omitempty"`        // 设置返回数量限制，默认为30
+	Page        *int           `url:"page,omitempty"`         // 返回当前数量限制下第N页的数据，默认为1（第一页）
+	Order       *Order         `url:"order,omitempty"`        // 排序规则，规则：字段名 ASC或者DESC
+	Fields      *Multi[string] `url:"fields,omitempty"`       // 设置获取的字段，多个字段间以','逗号隔开
+}
+
+// GetBaselines 获取基线接口
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/setting/get_baselines.html
+func (s *SettingService) GetBaselines(
+	ctx context.Context, request *GetBaselinesRequest, opts ...RequestOption,
+) ([]*Baseline, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "baselines", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var items []struct {
+		Baseline *Baseline `json:"Baseline"`
+	}
+	resp, err := s.client.Do(req, &items)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	baselines := make([]*Baseline, 0, len(items))
+	for _, item := range items {
+		baselines = append(baselines, item.Baseline)
+	}
+
+	return baselines, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 获取基线数量接口
+// -----------------------------------------------------------------------------
+
+type GetBaselinesCountRequest struct {
+	WorkspaceID *int          `url:"workspace_id,omitempty"` // [必须]项目ID
+	ID          *Multi[int64] `url:"id,omitempty"`           // ID	支持多ID查询
+	Name        *string       `url:"name,omitempty"`         // 基线名称	支持模糊匹配
+	Description *string       `url:"description,omitempty"`  // 详细描述
+	Creator     *string       `url:"creator,omitempty"`      // 创建人
+	Created     *TimeQuery    `url:"created,omitempty"`      // 创建时间	支持时间查询
+	Modified    *TimeQuery    `url:"modified,omitempty"`     // 最后修改时间	支持时间查询
+}
+
+// GetBaselinesCount 获取基线数量接口
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/setting/get_baselines_count.html
+func (s *SettingService) GetBaselinesCount(
+	ctx context.Context, request *GetBaselinesCountRequest, opts ...RequestOption,
+) (int, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "baselines/count", request, opts)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var response CountResponse
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return 0, resp, err
+	}
+
+	return response.Count, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 更新基线接口
+// -----------------------------------------------------------------------------
+
+type UpdateBaselineRequest struct {
+	WorkspaceID *int    `json:"workspace_id,omitempty"` // [必须]项目ID
+	ID          *int64  `json:"id,omitempty"`           // [必须]ID
+	Name        *string `json:"name,omitempty"`         // 基线名称
+	Description *string `json:"description,omitempty"`  // 详细描述
+	Modifier    *string `json:"modifier,omitempty"`     // 最后修改人
+}
+
+// UpdateBaseline 更新基线接口
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/setting/update_baseline.html
+func (s *SettingService) UpdateBaseline(
+	ctx context.Context, request *UpdateBaselineRequest, opts ...RequestOption,
+) (*Baseline, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "baselines", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var item struct {
+		Baseline *Baseline `json:"Baseline"`
+	}
+	resp, err := s.client.Do(req, &item)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return item.Baseline, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 创建特性接口
+// -----------------------------------------------------------------------------
+
+type CreateFeatureRequest struct {
+	WorkspaceID *int    `json:"workspace_id,omitempty"` // [必须]项目ID
+	Name        *string `json:"name,omitempty"`         // [必须]特性名称
+	Description *string `json:"description,omitempty"`  // 详细描述
+	Status      *string `json:"status,omitempty"`       // 状态
+	Creator     *string `json:"creator,omitempty"`      // 创建人
+}
+
+// CreateFeature 创建特性接口
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/setting/add_feature.html
+func (s *SettingService) CreateFeature(
+	ctx context.Context, request *CreateFeatureRequest, opts ...RequestOption,
+) (*Feature, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "features", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var item struct {
+		Feature *Feature `json:"Feature"`
+	}
+	resp, err := s.client.Do(req, &item)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return item.Feature, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 获取特性接口
+// -----------------------------------------------------------------------------
+
+type GetFeaturesRequest struct {
+	WorkspaceID *int           `url:"workspace_id,omitempty"` // [必须]项目ID
+	ID          *Multi[int64]  `url:"id,omitempty"`           // ID	支持多ID查询
+	Name        *string        `url:"name,omitempty"`         // 特性名称	支持模糊匹配
+	Description *string        `url:"description,omitempty"`  // 详细描述
+	Status      *string        `url:"status,omitempty"`       // 状态
+	Creator     *string        `url:"creator,omitempty"`      // 创建人
+	Created     *TimeQuery     `url:"created,omitempty"`      // 创建时间	支持时间查询
+	Modified    *TimeQuery     `url:"modified,omitempty"`     // 最后修改时间	支持时间查询
+	Limit       *int           `url:"limit,omitempty"`        // 设置返回数量限制，默认为30
+	Page        *int           `url:"page,omitempty"`         // 返回当前数量限制下第N页的数据，默认为1（第一页）
+	Order       *Order         `url:"order,omitempty"`        // 排序规则，规则：字段名 ASC或者DESC
+	Fields      *Multi[string] `url:"fields,omitempty"`       // 设置获取的字段，多个字段间以','逗号隔开
+}
+
+// GetFeatures 获取特性接口
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/setting/get_features.html
+func (s *SettingService) GetFeatures(
+	ctx context.Context, request *GetFeaturesRequest, opts ...RequestOption,
+) ([]*Feature, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "features", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var items []struct {
+		Feature *Feature `json:"Feature"`
+	}
+	resp, err := s.client.Do(req, &items)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	features := make([]*Feature, 0, len(items))
+	for _, item := range items {
+		features = append(features, item.Feature)
+	}
+
+	return features, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 获取特性数量接口
+// -----------------------------------------------------------------------------
+
+type GetFeaturesCountRequest struct {
+	WorkspaceID *int          `url:"workspace_id,omitempty"` // [必须]项目ID
+	ID          *Multi[int64] `url:"id,omitempty"`           // ID	支持多ID查询
+	Name        *string       `url:"name,omitempty"`         // 特性名称	支持模糊匹配
+	Description *string       `url:"description,omitempty"`  // 详细描述
+	Status      *string       `url:"status,omitempty"`       // 状态
+	Creator     *string       `url:"creator,omitempty"`      // 创建人
+	Created     *TimeQuery    `url:"created,omitempty"`      // 创建时间	支持时间查询
+	Modified    *TimeQuery    `url:"modified,omitempty"`     // 最后修改时间	支持时间查询
+}
+
+// GetFeaturesCount 获取特性数量接口
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/setting/get_features_count.html
+func (s *SettingService) GetFeaturesCount(
+	ctx context.Context, request *GetFeaturesCountRequest, opts ...RequestOption,
+) (int, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "features/count", request, opts)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var response CountResponse
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return 0, resp, err
+	}
+
+	return response.Count, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 更新特性接口
+// -----------------------------------------------------------------------------
+
+type UpdateFeatureRequest struct {
+	WorkspaceID *int    `json:"workspace_id,omitempty"` // [必须]项目ID
+	ID          *int64  `json:"id,omitempty"`           // [必须]ID
+	Name        *string `json:"name,omitempty"`         // 特性名称
+	Description *string `json:"description,omitempty"`  // 详细描述
+	Status      *string `json:"status,omitempty"`       // 状态
+	Modifier    *string `json:"modifier,omitempty"`     // 最后修改人
+}
+
+// UpdateFeature 更新特性接口
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/setting/update_feature.html
+func (s *SettingService) UpdateFeature(
+	ctx context.Context, request *UpdateFeatureRequest, opts ...RequestOption,
+) (*Feature, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "features", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var item struct {
+		Feature *Feature `json:"Feature"`
+	}
+	resp, err := s.client.Do(req, &item)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return item.Feature, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 更新下拉类型自定义字段候选值
+// -----------------------------------------------------------------------------
+
+type UpdateCustomFieldOptionsRequest struct {
+	WorkspaceID *int     `json:"workspace_id,omitempty"` // [必须]项目ID
+	EntryType   *string  `json:"entry_type,omitempty"`   // [必须]所属实体对象，可选值：stories,bugs,tasks
+	CustomField *string  `json:"custom_field,omitempty"` // [必须]自定义字段标识（英文名），如 custom_field_one
+	Options     []string `json:"options,omitempty"`      // [必须]候选值，会覆盖原有的候选值
+	Operator    *string  `json:"operator,omitempty"`     // 操作人
+}
+
+// UpdateCustomFieldOptions 更新下拉类型自定义字段候选值
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/setting/update_custom_field_options.html
+func (s *SettingService) UpdateCustomFieldOptions(
+	ctx context.Context, request *UpdateCustomFieldOptionsRequest, opts ...RequestOption,
+) (*CustomFieldsSetting, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "custom_field_configs/update_options", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var item struct {
+		CustomFieldConfig *CustomFieldsSetting `json:"CustomFieldConfig"`
+	}
+	resp, err := s.client.Do(req, &item)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return item.CustomFieldConfig, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 更新需求下拉类型自定义字段候选值
+// -----------------------------------------------------------------------------
+
+type UpdateStoryCustomFieldOptionsRequest struct {
+	WorkspaceID *int     `json:"workspace_id,omitempty"` // [必须]项目ID
+	CustomField *string  `json:"custom_field,omitempty"` // [必须]自定义字段标识（英文名），如 custom_field_one
+	Options     []string `json:"options,omitempty"`      // [必须]候选值，会覆盖原有的候选值
+	Operator    *string  `json:"operator,omitempty"`     // 操作人
+}
+
+// UpdateStoryCustomFieldOptions 更新需求下拉类型自定义字段候选值
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/setting/update_story_custom_field_options.html
+func (s *SettingService) UpdateStoryCustomFieldOptions(
+	ctx context.Context, request *UpdateStoryCustomFieldOptionsRequest, opts ...RequestOption,
+) (*StoryCustomFieldsSetting, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "stories/update_custom_field_options", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var item struct {
+		CustomFieldConfig *StoryCustomFieldsSetting `json:"CustomFieldConfig"`
+	}
+	resp, err := s.client.Do(req, &item)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return item.CustomFieldConfig, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 更新缺陷下拉类型自定义字段候选值
+// -----------------------------------------------------------------------------
+
+type UpdateBugCustomFieldOptionsRequest struct {
+	WorkspaceID *int     `json:"workspace_id,omitempty"` // [必须]项目ID
+	CustomField *string  `json:"custom_field,omitempty"` // [必须]自定义字段标识（英文名），如 custom_field_one
+	Options     []string `json:"options,omitempty"`      // [必须]候选值，会覆盖原有的候选值
+	Operator    *string  `json:"operator,omitempty"`     // 操作人
+}
+
+// UpdateBugCustomFieldOptions 更新缺陷下拉类型自定义字段候选值
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/setting/update_bug_custom_field_options.html
+func (s *SettingService) UpdateBugCustomFieldOptions(
+	ctx context.Context, request *UpdateBugCustomFieldOptionsRequest, opts ...RequestOption,
+) (*CustomFieldsSetting, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "bugs/update_custom_field_options", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var item struct {
+		CustomFieldConfig *CustomFieldsSetting `json:"CustomFieldConfig"`
+	}
+	resp, err := s.client.Do(req, &item)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return item.CustomFieldConfig, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 更新级联自定义字段侯选值
+// -----------------------------------------------------------------------------
+
+type UpdateCascadeCustomFieldOptionsRequest struct {
+	WorkspaceID *int             `json:"workspace_id,omitempty"` // [必须]项目ID
+	EntryType   *string          `json:"entry_type,omitempty"`   // [必须]所属实体对象，可选值：stories,bugs,tasks
+	CustomField *string          `json:"custom_field,omitempty"` // [必须]自定义字段标识（英文名），如 custom_field_one
+	Options     []*CascadeOption `json:"options,omitempty"`      // [必须]级联候选值，会覆盖原有的候选值
+	Operator    *string          `json:"operator,omitempty"`     // 操作人
+}
+
+// UpdateCascadeCustomFieldOptions 更新级联自定义字段侯选值
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/setting/update_cascade_custom_field_options.html
+func (s *SettingService) UpdateCascadeCustomFieldOptions(
+	ctx context.Context, request *UpdateCascadeCustomFieldOptionsRequest, opts ...RequestOption,
+) (*CustomFieldsSetting, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "custom_field_configs/update_cascade_options", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var item struct {
+		CustomFieldConfig *CustomFieldsSetting `json:"CustomFieldConfig"`
+	}
+	resp, err := s.client.Do(req, &item)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return item.CustomFieldConfig, resp, nil
+}
@@ -0,0 +1,527 @@
+package tapd
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSettingService_CreateModule(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/modules", r.URL.Path)
+
+		var req struct {
+			WorkspaceID int    `json:"workspace_id"`
+			Name        string `json:"name"`
+			Owner       string `json:"owner"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, 11112222, req.WorkspaceID)
+		assert.Equal(t, "订单", req.Name)
+		assert.Equal(t, "张三", req.Owner)
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/setting/create_module.json"))
+	}))
+
+	module, _, err := client.SettingService.CreateModule(ctx, &CreateModuleRequest{
+		WorkspaceID: Ptr(11112222),
+		Name:        Ptr("订单"),
+		Description: Ptr("订单模块"),
+		Owner:       Ptr("张三"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "1111112222001000221", module.ID)
+	assert.Equal(t, "11112222", module.WorkspaceID)
+	assert.Equal(t, "订单", module.Name)
+	assert.Equal(t, "张三", module.Owner)
+}
+
+func TestSettingService_GetModules(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/modules", r.URL.Path)
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+		assert.Equal(t, "张三", r.URL.Query().Get("owner"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/setting/get_modules.json"))
+	}))
+
+	modules, _, err := client.SettingService.GetModules(ctx, &GetModulesRequest{
+		WorkspaceID: Ptr(11112222),
+		Owner:       Ptr("张三"),
+	})
+	require.NoError(t, err)
+	require.Len(t, modules, 2)
+	assert.Equal(t, "1111112222001000221", modules[0].ID)
+	assert.Equal(t, "1111112222001000222", modules[1].ID)
+	assert.Equal(t, "支付", modules[1].Name)
+}
+
+func TestSettingService_GetModulesCount(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/modules/count", r.URL.Path)
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/setting/get_modules_count.json"))
+	}))
+
+	count, _, err := client.SettingService.GetModulesCount(ctx, &GetModulesCountRequest{
+		WorkspaceID: Ptr(11112222),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+}
+
+func TestSettingService_UpdateModule(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/modules", r.URL.Path)
+
+		var req struct {
+			WorkspaceID int    `json:"workspace_id"`
+			ID          int64  `json:"id"`
+			Owner       string `json:"owner"`
+			Modifier    string `json:"modifier"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, 11112222, req.WorkspaceID)
+		assert.Equal(t, int64(1111112222001000221), req.ID)
+		assert.Equal(t, "王五", req.Owner)
+		assert.Equal(t, "李四", req.Modifier)
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/setting/update_module.json"))
+	}))
+
+	module, _, err := client.SettingService.UpdateModule(ctx, &UpdateModuleRequest{
+		WorkspaceID: Ptr(11112222),
+		ID:          Ptr[int64](1111112222001000221),
+		Owner:       Ptr("王五"),
+		Modifier:    Ptr("李四"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "1111112222001000221", module.ID)
+	assert.Equal(t, "王五", module.Owner)
+	assert.Equal(t, "李四", module.Modifier)
+	assert.Equal(t, "2025-02-01 10:00:00", module.Modified)
+}
+
+func TestSettingService_CreateVersion(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/versions", r.URL.Path)
+
+		var req struct {
+			WorkspaceID int    `json:"workspace_id"`
+			Name        string `json:"name"`
+			Description string `json:"description"`
+			Creator     string `json:"creator"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, 11112222, req.WorkspaceID)
+		assert.Equal(t, "v1.2.0", req.Name)
+		assert.Equal(t, "git tag v1.2.0", req.Description)
+		assert.Equal(t, "release-bot", req.Creator)
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/setting/create_version.json"))
+	}))
+
+	version, _, err := client.SettingService.CreateVersion(ctx, &CreateVersionRequest{
+		WorkspaceID: Ptr(11112222),
+		Name:        Ptr("v1.2.0"),
+		Description: Ptr("git tag v1.2.0"),
+		Creator:     Ptr("release-bot"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "1111112222001000231", version.ID)
+	assert.Equal(t, "11112222", version.WorkspaceID)
+	assert.Equal(t, "v1.2.0", version.Name)
+	assert.Equal(t, "open", version.Status)
+	assert.Equal(t, "release-bot", version.Creator)
+}
+
+func TestSettingService_GetVersions(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/versions", r.URL.Path)
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+		assert.Equal(t, "v1.", r.URL.Query().Get("name"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/setting/get_versions.json"))
+	}))
+
+	versions, _, err := client.SettingService.GetVersions(ctx, &GetVersionsRequest{
+		WorkspaceID: Ptr(11112222),
+		Name:        Ptr("v1."),
+	})
+	require.NoError(t, err)
+	require.Len(t, versions, 2)
+	assert.Equal(t, "1111112222001000231", versions[0].ID)
+	assert.Equal(t, "1111112222001000232", versions[1].ID)
+	assert.Equal(t, "v1.1.0", versions[1].Name)
+}
+
+func TestSettingService_GetVersionsCount(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/versions/count", r.URL.Path)
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/setting/get_versions_count.json"))
+	}))
+
+	count, _, err := client.SettingService.GetVersionsCount(ctx, &GetVersionsCountRequest{
+		WorkspaceID: Ptr(11112222),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+}
+
+func TestSettingService_UpdateVersion(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/versions", r.URL.Path)
+
+		var req struct {
+			WorkspaceID int    `json:"workspace_id"`
+			ID          int64  `json:"id"`
+			Status      string `json:"status"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, 11112222, req.WorkspaceID)
+		assert.Equal(t, int64(1111112222001000231), req.ID)
+		assert.Equal(t, "closed", req.Status)
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/setting/update_version.json"))
+	}))
+
+	version, _, err := client.SettingService.UpdateVersion(ctx, &UpdateVersionRequest{
+		WorkspaceID: Ptr(11112222),
+		ID:          Ptr[int64](1111112222001000231),
+		Status:      Ptr("closed"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "1111112222001000231", version.ID)
+	assert.Equal(t, "closed", version.Status)
+	assert.Equal(t, "2025-02-01 10:00:00", version.Modified)
+}
+
+func TestSettingService_CreateBaseline(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/baselines", r.URL.Path)
+
+		var req struct {
+			WorkspaceID int    `json:"workspace_id"`
+			Name        string `json:"name"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, 11112222, req.WorkspaceID)
+		assert.Equal(t, "2025Q1 基线", req.Name)
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/setting/create_baseline.json"))
+	}))
+
+	baseline, _, err := client.SettingService.CreateBaseline(ctx, &CreateBaselineRequest{
+		WorkspaceID: Ptr(11112222),
+		Name:        Ptr("2025Q1 基线"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "1111112222001000241", baseline.ID)
+	assert.Equal(t, "11112222", baseline.WorkspaceID)
+	assert.Equal(t, "2025Q1 基线", baseline.Name)
+}
+
+func TestSettingService_GetBaselines(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/baselines", r.URL.Path)
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+		assert.Equal(t, "基线", r.URL.Query().Get("name"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/setting/get_baselines.json"))
+	}))
+
+	baselines, _, err := client.SettingService.GetBaselines(ctx, &GetBaselinesRequest{
+		WorkspaceID: Ptr(11112222),
+		Name:        Ptr("基线"),
+	})
+	require.NoError(t, err)
+	require.Len(t, baselines, 2)
+	assert.Equal(t, "1111112222001000241", baselines[0].ID)
+	assert.Equal(t, "1111112222001000242", baselines[1].ID)
+	assert.Equal(t, "2025Q2 基线", baselines[1].Name)
+}
+
+func TestSettingService_GetBaselinesCount(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/baselines/count", r.URL.Path)
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/setting/get_baselines_count.json"))
+	}))
+
+	count, _, err := client.SettingService.GetBaselinesCount(ctx, &GetBaselinesCountRequest{
+		WorkspaceID: Ptr(11112222),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+}
+
+func TestSettingService_UpdateBaseline(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/baselines", r.URL.Path)
+
+		var req struct {
+			WorkspaceID int    `json:"workspace_id"`
+			ID          int64  `json:"id"`
+			Description string `json:"description"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, 11112222, req.WorkspaceID)
+		assert.Equal(t, int64(1111112222001000241), req.ID)
+		assert.Equal(t, "一季度发布基线", req.Description)
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/setting/update_baseline.json"))
+	}))
+
+	baseline, _, err := client.SettingService.UpdateBaseline(ctx, &UpdateBaselineRequest{
+		WorkspaceID: Ptr(11112222),
+		ID:          Ptr[int64](1111112222001000241),
+		Description: Ptr("一季度发布基线"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "1111112222001000241", baseline.ID)
+	assert.Equal(t, "一季度发布基线", baseline.Description)
+	assert.Equal(t, "2025-02-01 10:00:00", baseline.Modified)
+}
+
+func TestSettingService_CreateFeature(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/features", r.URL.Path)
+
+		var req struct {
+			WorkspaceID int    `json:"workspace_id"`
+			Name        string `json:"name"`
+			Status      string `json:"status"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, 11112222, req.WorkspaceID)
+		assert.Equal(t, "扫码支付", req.Name)
+		assert.Equal(t, "open", req.Status)
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/setting/create_feature.json"))
+	}))
+
+	feature, _, err := client.SettingService.CreateFeature(ctx, &CreateFeatureRequest{
+		WorkspaceID: Ptr(11112222),
+		Name:        Ptr("扫码支付"),
+		Status:      Ptr("open"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "1111112222001000251", feature.ID)
+	assert.Equal(t, "11112222", feature.WorkspaceID)
+	assert.Equal(t, "扫码支付", feature.Name)
+	assert.Equal(t, "open", feature.Status)
+}
+
+func TestSettingService_GetFeatures(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/features", r.URL.Path)
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+		assert.Equal(t, "open", r.URL.Query().Get("status"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/setting/get_features.json"))
+	}))
+
+	features, _, err := client.SettingService.GetFeatures(ctx, &GetFeaturesRequest{
+		WorkspaceID: Ptr(11112222),
+		Status:      Ptr("open"),
+	})
+	require.NoError(t, err)
+	require.Len(t, features, 2)
+	assert.Equal(t, "1111112222001000251", features[0].ID)
+	assert.Equal(t, "1111112222001000252", features[1].ID)
+	assert.Equal(t, "分期付款", features[1].Name)
+}
+
+func TestSettingService_GetFeaturesCount(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/features/count", r.URL.Path)
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/setting/get_features_count.json"))
+	}))
+
+	count, _, err := client.SettingService.GetFeaturesCount(ctx, &GetFeaturesCountRequest{
+		WorkspaceID: Ptr(11112222),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+}
+
+func TestSettingService_UpdateFeature(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/features", r.URL.Path)
+
+		var req struct {
+			WorkspaceID int    `json:"workspace_id"`
+			ID          int64  `json:"id"`
+			Status      string `json:"status"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, 11112222, req.WorkspaceID)
+		assert.Equal(t, int64(1111112222001000251), req.ID)
+		assert.Equal(t, "done", req.Status)
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/setting/update_feature.json"))
+	}))
+
+	feature, _, err := client.SettingService.UpdateFeature(ctx, &UpdateFeatureRequest{
+		WorkspaceID: Ptr(11112222),
+		ID:          Ptr[int64](1111112222001000251),
+		Status:      Ptr("done"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "1111112222001000251", feature.ID)
+	assert.Equal(t, "done", feature.Status)
+	assert.Equal(t, "2025-02-01 10:00:00", feature.Modified)
+}
+
+func TestSettingService_UpdateCustomFieldOptions(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/custom_field_configs/update_options", r.URL.Path)
+
+		var req struct {
+			WorkspaceID int      `json:"workspace_id"`
+			EntryType   string   `json:"entry_type"`
+			CustomField string   `json:"custom_field"`
+			Options     []string `json:"options"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, 11112222, req.WorkspaceID)
+		assert.Equal(t, "tasks", req.EntryType)
+		assert.Equal(t, "custom_field_one", req.CustomField)
+		assert.Equal(t, []string{"开发", "测试", "生产"}, req.Options)
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/setting/update_custom_field_options.json"))
+	}))
+
+	setting, _, err := client.SettingService.UpdateCustomFieldOptions(ctx, &UpdateCustomFieldOptionsRequest{
+		WorkspaceID: Ptr(11112222),
+		EntryType:   Ptr("tasks"),
+		CustomField: Ptr("custom_field_one"),
+		Options:     []string{"开发", "测试", "生产"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "tasks", setting.EntryType)
+	assert.Equal(t, "custom_field_one", setting.CustomField)
+	require.NotNil(t, setting.Options)
+	assert.Equal(t, "开发|测试|生产", *setting.Options)
+}
+
+func TestSettingService_UpdateStoryCustomFieldOptions(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/stories/update_custom_field_options", r.URL.Path)
+
+		var req struct {
+			WorkspaceID int      `json:"workspace_id"`
+			CustomField string   `json:"custom_field"`
+			Options     []string `json:"options"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, 11112222, req.WorkspaceID)
+		assert.Equal(t, "custom_field_one", req.CustomField)
+		assert.Equal(t, []string{"客户A", "客户B", "客户C"}, req.Options)
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/setting/update_story_custom_field_options.json"))
+	}))
+
+	setting, _, err := client.SettingService.UpdateStoryCustomFieldOptions(ctx, &UpdateStoryCustomFieldOptionsRequest{
+		WorkspaceID: Ptr(11112222),
+		CustomField: Ptr("custom_field_one"),
+		Options:     []string{"客户A", "客户B", "客户C"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "stories", setting.EntryType)
+	assert.Equal(t, "客户名称", setting.Name)
+	require.NotNil(t, setting.Options)
+	assert.Equal(t, "客户A|客户B|客户C", *setting.Options)
+}
+
+func TestSettingService_UpdateBugCustomFieldOptions(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/bugs/update_custom_field_options", r.URL.Path)
+
+		var req struct {
+			WorkspaceID int      `json:"workspace_id"`
+			CustomField string   `json:"custom_field"`
+			Options     []string `json:"options"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, 11112222, req.WorkspaceID)
+		assert.Equal(t, "custom_field_one", req.CustomField)
+		assert.Equal(t, []string{"测试", "预发布", "生产"}, req.Options)
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/setting/update_bug_custom_field_options.json"))
+	}))
+
+	setting, _, err := client.SettingService.UpdateBugCustomFieldOptions(ctx, &UpdateBugCustomFieldOptionsRequest{
+		WorkspaceID: Ptr(11112222),
+		CustomField: Ptr("custom_field_one"),
+		Options:     []string{"测试", "预发布", "生产"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "bugs", setting.EntryType)
+	assert.Equal(t, "发现环境", setting.Name)
+}
+
+func TestSettingService_UpdateCascadeCustomFieldOptions(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/custom_field_configs/update_cascade_options", r.URL.Path)
+
+		var req map[string]any
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "stories", req["entry_type"])
+		assert.Equal(t, "custom_field_two", req["custom_field"])
+		assert.Equal(t, []any{
+			map[string]any{
+				"value": "广东",
+				"children": []any{
+					map[string]any{"value": "深圳"},
+					map[string]any{"value": "广州"},
+				},
+			},
+			map[string]any{"value": "北京"},
+		}, req["options"])
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/setting/update_cascade_custom_field_options.json"))
+	}))
+
+	setting, _, err := client.SettingService.UpdateCascadeCustomFieldOptions(ctx, &UpdateCascadeCustomFieldOptionsRequest{
+		WorkspaceID: Ptr(11112222),
+		EntryType:   Ptr("stories"),
+		CustomField: Ptr("custom_field_two"),
+		Options: []*CascadeOption{
+			{Value: "广东", Children: []*CascadeOption{{Value: "深圳"}, {Value: "广州"}}},
+			{Value: "北京"},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "custom_field_two", setting.CustomField)
+	assert.Equal(t, "cascade_radio", setting.Type)
+}
@@ -0,0 +1,269 @@
+package tapd
+
+import (
+	"context"
+	"net/http"
+)
+
+// GitCommit 代码提交记录
+type GitCommit struct {
+	ID          string     `json:"id,omitempty"`           // ID
+	WorkspaceID string     `json:"workspace_id,omitempty"` // 项目ID
+	CommitID    string     `json:"commit_id,omitempty"`    // 提交哈希
+	Message     string     `json:"message,omitempty"`      // 提交说明
+	Author      string     `json:"author,omitempty"`       // 提交人
+	CommitTime  string     `json:"commit_time,omitempty"`  // 提交时间
+	RepoName    string     `json:"repo_name,omitempty"`    // 代码仓库名称
+	RepoURL     string     `json:"repo_url,omitempty"`     // 代码仓库地址
+	Branch      string     `json:"branch,omitempty"`       // 分支
+	WebURL      string     `json:"web_url,omitempty"`      // 提交详情地址
+	ObjectType  EntityType `json:"object_type,omitempty"`  // 关联业务对象类型
+	ObjectID    string     `json:"object_id,omitempty"`    // 关联业务对象ID
+	Created     string     `json:"created,omitempty"`      // 创建时间
+}
+
+// CommitRelatedObject 代码提交关联的业务对象
+type CommitRelatedObject struct {
+	WorkspaceID string     `json:"workspace_id,omitempty"` // 项目ID
+	ObjectType  EntityType `json:"object_type,omitempty"`  // 业务对象类型
+	ObjectID    string     `json:"object_id,omitempty"`    // 业务对象ID
+	Name        string     `json:"name,omitempty"`         // 业务对象标题
+	Status      string     `json:"status,omitempty"`       // 业务对象状态
+}
+
+// BranchRelation 工作项与 Git 分支的关联关系
+type BranchRelation struct {
+	ID          string     `json:"id,omitempty"`           // ID
+	WorkspaceID string     `json:"workspace_id,omitempty"` // 项目ID
+	ObjectType  EntityType `json:"object_type,omitempty"`  // 业务对象类型
+	ObjectID    string     `json:"object_id,omitempty"`    // 业务对象ID
+	RepoName    string     `json:"repo_name,omitempty"`    // 代码仓库名称
+	RepoURL     string     `json:"repo_url,omitempty"`     // 代码仓库地址
+	Branch      string     `json:"branch,omitempty"`       // 分支
+	Creator     string     `json:"creator,omitempty"`      // 创建人
+	Created     string     `json:"created,omitempty"`      // 创建时间
+}
+
+// SourceCodeService 源码服务
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/source_code/
+type SourceCodeService struct {
+	client *Client
+}
+
+// -----------------------------------------------------------------------------
+// 保存Commit提交数据
+// -----------------------------------------------------------------------------
+
+type SaveCommitRequest struct {
+	WorkspaceID *int    `json:"workspace_id,omitempty"` // [必须]项目ID
+	CommitID    *string `json:"commit_id,omitempty"`    // [必须]提交哈希
+	Message     *string `json:"message,omitempty"`      // [必须]提交说明，包含 --story=1001234 这类关联语法时会关联到对应业务对象
+	Author      *string `json:"author,omitempty"`       // [必须]提交人
+	CommitTime  *string `json:"commit_time,omitempty"`  // 提交时间
+	RepoName    *string `json:"repo_name,omitempty"`    // 代码仓库名称
+	RepoURL     *string `json:"repo_url,omitempty"`     // 代码仓库地址
+	Branch      *string `json:"branch,omitempty"`       // 分支
+	WebURL      *string `json:"web_url,omitempty"`      // 提交详情地址
+}
+
+// SaveCommit 保存Commit提交数据
+//
+// 提交说明中的关联语法可以用 ParseSmartCommit 预先解析。
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/source_code/save_commit.html
+func (s *SourceCodeService) SaveCommit(
+	ctx context.Context, request *SaveCommitRequest, opts ...RequestOption,
+) ([]*GitCommit, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "code_commit_infos", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var items []struct {
+		GitCommit *GitCommit `json:"GitCommit"`
+	}
+	resp, err := s.client.Do(req, &items)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	commits := make([]*GitCommit, 0, len(items))
+	for _, item := range items {
+		commits = append(commits, item.GitCommit)
+	}
+
+	return commits, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 获取GIT关联提交数据(GitCommit)
+// -----------------------------------------------------------------------------
+
+type GetCommitsRequest struct {
+	WorkspaceID *int        `url:"workspace_id,omitempty"` // [必须]项目ID
+	Type        *EntityType `url:"type,omitempty"`         // [必须]业务对象类型，可选值：story,bug,task
+	ObjectID    *int64      `url:"object_id,omitempty"`    // [必须]业务对象ID
+	Limit       *int        `url:"limit,omitempty"`        // 设置返回数量限制，默认为30
+	Page        *int        `url:"page,omitempty"`         // 返回当前数量限制下第N页的数据，默认为1（第一页）
+	Order       *Order      `url:"order,omitempty"`        // 排序规则，规则：字段名 ASC或者DESC
+}
+
+// GetCommits 获取GIT关联提交数据(GitCommit)
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/source_code/get_scm_commits.html
+func (s *SourceCodeService) GetCommits(
+	ctx context.Context, request *GetCommitsRequest, opts ...RequestOption,
+) ([]*GitCommit, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "code_commit_infos", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var items []struct {
+		GitCommit *GitCommit `json:"GitCommit"`
+	}
+	resp, err := s.client.Do(req, &items)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	commits := make([]*GitCommit, 0, len(items))
+	for _, item := range items {
+		commits = append(commits, item.GitCommit)
+	}
+
+	return commits, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 获取指定commit关联的业务对象
+// -----------------------------------------------------------------------------
+
+type GetCommitRelatedObjectsRequest struct {
+	WorkspaceID *int    `url:"workspace_id,omitempty"` // [必须]项目ID
+	CommitID    *string `url:"commit_id,omitempty"`    // [必须]提交哈希
+}
+
+// GetCommitRelatedObjects 获取指定commit关联的业务对象
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/source_code/get_commit_related_objects.html
+func (s *SourceCodeService) GetCommitRelatedObjects(
+	ctx context.Context, request *GetCommitRelatedObjectsRequest, opts ...RequestOption,
+) ([]*CommitRelatedObject, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "code_commit_infos/related_objects", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var objects []*CommitRelatedObject
+	resp, err := s.client.Do(req, &objects)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return objects, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 创建工作项和Git分支关联关系
+// -----------------------------------------------------------------------------
+
+type CreateBranchRelationRequest struct {
+	WorkspaceID *int        `json:"workspace_id,omitempty"` // [必须]项目ID
+	ObjectType  *EntityType `json:"object_type,omitempty"`  // [必须]业务对象类型，可选值：story,bug,task
+	ObjectID    *int64      `json:"object_id,omitempty"`    // [必须]业务对象ID
+	Branch      *string     `json:"branch,omitempty"`       // [必须]分支
+	RepoName    *string     `json:"repo_name,omitempty"`    // [必须]代码仓库名称
+	RepoURL     *string     `json:"repo_url,omitempty"`     // 代码仓库地址
+	Creator     *string     `json:"creator,omitempty"`      // 创建人
+}
+
+// CreateBranchRelation 创建工作项和Git分支关联关系
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/source_code/add_branch_relation.html
+func (s *SourceCodeService) CreateBranchRelation(
+	ctx context.Context, request *CreateBranchRelationRequest, opts ...RequestOption,
+) (*BranchRelation, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "code_branch_relations", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var item struct {
+		BranchRelation *BranchRelation `json:"BranchRelation"`
+	}
+	resp, err := s.client.Do(req, &item)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return item.BranchRelation, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 获取工作项和Git分支的关联关系
+// -----------------------------------------------------------------------------
+
+type GetBranchRelationsRequest struct {
+	WorkspaceID *int        `url:"workspace_id,omitempty"` // [必须]项目ID
+	ObjectType  *EntityType `url:"object_type,omitempty"`  // 业务对象类型，可选值：story,bug,task
+	ObjectID    *int64      `url:"object_id,omitempty"`    // 业务对象ID
+	RepoName    *string     `url:"repo_name,omitempty"`    // 代码仓库名称
+	Branch      *string     `url:"branch,omitempty"`       // 分支，传入时返回分支关联的工作项
+	Limit       *int        `url:"limit,omitempty"`        // 设置返回数量限制，默认为30
+	Page        *int        `url:"page,omitempty"`         // 返回当前数量限制下第N页的数据，默认为1（第一页）
+}
+
+// GetBranchRelations 获取工作项和Git分支的关联关系
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/source_code/get_branch_relations.html
+func (s *SourceCodeService) GetBranchRelations(
+	ctx context.Context, request *GetBranchRelationsRequest, opts ...RequestOption,
+) ([]*BranchRelation, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "code_branch_relations", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var items []struct {
+		BranchRelation *BranchRelation `json:"BranchRelation"`
+	}
+	resp, err := s.client.Do(req, &items)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	relations := make([]*BranchRelation, 0, len(items))
+	for _, item := range items {
+		relations = append(relations, item.BranchRelation)
+	}
+
+	return relations, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 解除工作项和Git分支关联
+// -----------------------------------------------------------------------------
+
+type DeleteBranchRelationRequest struct {
+	WorkspaceID *int        `json:"workspace_id,omitempty"` // [必须]项目ID
+	ObjectType  *EntityType `json:"object_type,omitempty"`  // [必须]业务对象类型，可选值：story,bug,task
+	ObjectID    *int64      `json:"object_id,omitempty"`    // [必须]业务对象ID
+	Branch      *string     `json:"branch,omitempty"`       // [必须]分支
+	RepoName    *string     `json:"repo_name,omitempty"`    // [必须]代码仓库名称
+}
+
+// DeleteBranchRelation 解除工作项和Git分支关联
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/source_code/delete_branch_relation.html
+func (s *SourceCodeService) DeleteBranchRelation(
+	ctx context.Context, request *DeleteBranchRelationRequest, opts ...RequestOption,
+) (*Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "code_branch_relations/delete", request, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
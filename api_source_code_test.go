@@ -0,0 +1,177 @@
+package tapd
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSourceCodeService_SaveCommit(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/code_commit_infos", r.URL.Path)
+
+		var req struct {
+			WorkspaceID int    `json:"workspace_id"`
+			CommitID    string `json:"commit_id"`
+			Message     string `json:"message"`
+			Author      string `json:"author"`
+			Branch      string `json:"branch"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, 11112222, req.WorkspaceID)
+		assert.Equal(t, "9fceb02d0ae598e95dc970b74767f19372d61af8", req.CommitID)
+		assert.Equal(t, "--story=1001234 --user=alice fix login redirect", req.Message)
+		assert.Equal(t, "alice", req.Author)
+		assert.Equal(t, "main", req.Branch)
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/source_code/save_commit.json"))
+	}))
+
+	commits, _, err := client.SourceCodeService.SaveCommit(ctx, &SaveCommitRequest{
+		WorkspaceID: Ptr(11112222),
+		CommitID:    Ptr("9fceb02d0ae598e95dc970b74767f19372d61af8"),
+		Message:     Ptr("--story=1001234 --user=alice fix login redirect"),
+		Author:      Ptr("alice"),
+		Branch:      Ptr("main"),
+	})
+	require.NoError(t, err)
+	require.Len(t, commits, 1)
+	assert.Equal(t, "1111112222001000161", commits[0].ID)
+	assert.Equal(t, "9fceb02d0ae598e95dc970b74767f19372d61af8", commits[0].CommitID)
+	assert.Equal(t, EntityTypeStory, commits[0].ObjectType)
+	assert.Equal(t, "1111112222001001234", commits[0].ObjectID)
+}
+
+func TestSourceCodeService_GetCommits(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/code_commit_infos", r.URL.Path)
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+		assert.Equal(t, "story", r.URL.Query().Get("type"))
+		assert.Equal(t, "1111112222001001234", r.URL.Query().Get("object_id"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/source_code/get_commits.json"))
+	}))
+
+	commits, _, err := client.SourceCodeService.GetCommits(ctx, &GetCommitsRequest{
+		WorkspaceID: Ptr(11112222),
+		Type:        Ptr(EntityTypeStory),
+		ObjectID:    Ptr[int64](1111112222001001234),
+	})
+	require.NoError(t, err)
+	require.Len(t, commits, 2)
+	assert.Equal(t, "alice", commits[0].Author)
+	assert.Equal(t, "go-tapd/tapd", commits[0].RepoName)
+	assert.Equal(t, "3b18e512dba79e4c8300dd08aeb37f8e728b8dad", commits[1].CommitID)
+	assert.Equal(t, "bob", commits[1].Author)
+}
+
+func TestSourceCodeService_GetCommitRelatedObjects(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/code_commit_infos/related_objects", r.URL.Path)
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+		assert.Equal(t, "9fceb02d0ae598e95dc970b74767f19372d61af8", r.URL.Query().Get("commit_id"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/source_code/get_commit_related_objects.json"))
+	}))
+
+	objects, _, err := client.SourceCodeService.GetCommitRelatedObjects(ctx, &GetCommitRelatedObjectsRequest{
+		WorkspaceID: Ptr(11112222),
+		CommitID:    Ptr("9fceb02d0ae598e95dc970b74767f19372d61af8"),
+	})
+	require.NoError(t, err)
+	require.Len(t, objects, 2)
+	assert.Equal(t, EntityTypeStory, objects[0].ObjectType)
+	assert.Equal(t, "1111112222001001234", objects[0].ObjectID)
+	assert.Equal(t, "登录后跳转到原页面", objects[0].Name)
+	assert.Equal(t, EntityTypeBug, objects[1].ObjectType)
+	assert.Equal(t, "resolved", objects[1].Status)
+}
+
+func TestSourceCodeService_CreateBranchRelation(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/code_branch_relations", r.URL.Path)
+
+		var req struct {
+			WorkspaceID int        `json:"workspace_id"`
+			ObjectType  EntityType `json:"object_type"`
+			ObjectID    int64      `json:"object_id"`
+			Branch      string     `json:"branch"`
+			RepoName    string     `json:"repo_name"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, 11112222, req.WorkspaceID)
+		assert.Equal(t, EntityTypeStory, req.ObjectType)
+		assert.Equal(t, int64(1111112222001001234), req.ObjectID)
+		assert.Equal(t, "feature/login-redirect", req.Branch)
+		assert.Equal(t, "go-tapd/tapd", req.RepoName)
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/source_code/create_branch_relation.json"))
+	}))
+
+	relation, _, err := client.SourceCodeService.CreateBranchRelation(ctx, &CreateBranchRelationRequest{
+		WorkspaceID: Ptr(11112222),
+		ObjectType:  Ptr(EntityTypeStory),
+		ObjectID:    Ptr[int64](1111112222001001234),
+		Branch:      Ptr("feature/login-redirect"),
+		RepoName:    Ptr("go-tapd/tapd"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "1111112222001000171", relation.ID)
+	assert.Equal(t, "feature/login-redirect", relation.Branch)
+	assert.Equal(t, "1111112222001001234", relation.ObjectID)
+}
+
+func TestSourceCodeService_GetBranchRelations(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/code_branch_relations", r.URL.Path)
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+		assert.Equal(t, "feature/login-redirect", r.URL.Query().Get("branch"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/source_code/get_branch_relations.json"))
+	}))
+
+	relations, _, err := client.SourceCodeService.GetBranchRelations(ctx, &GetBranchRelationsRequest{
+		WorkspaceID: Ptr(11112222),
+		Branch:      Ptr("feature/login-redirect"),
+	})
+	require.NoError(t, err)
+	require.Len(t, relations, 1)
+	assert.Equal(t, EntityTypeStory, relations[0].ObjectType)
+	assert.Equal(t, "1111112222001001234", relations[0].ObjectID)
+}
+
+func TestSourceCodeService_DeleteBranchRelation(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/code_branch_relations/delete", r.URL.Path)
+
+		var req struct {
+			WorkspaceID int    `json:"workspace_id"`
+			ObjectID    int64  `json:"object_id"`
+			Branch      string `json:"branch"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, 11112222, req.WorkspaceID)
+		assert.Equal(t, int64(1111112222001001234), req.ObjectID)
+		assert.Equal(t, "feature/login-redirect", req.Branch)
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/source_code/delete_branch_relation.json"))
+	}))
+
+	_, err := client.SourceCodeService.DeleteBranchRelation(ctx, &DeleteBranchRelationRequest{
+		WorkspaceID: Ptr(11112222),
+		ObjectType:  Ptr(EntityTypeStory),
+		ObjectID:    Ptr[int64](1111112222001001234),
+		Branch:      Ptr("feature/login-redirect"),
+		RepoName:    Ptr("go-tapd/tapd"),
+	})
+	require.NoError(t, err)
+}
@@ -0,0 +1,173 @@
+package tapd
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-tapd/tapd/storage"
+)
+
+// StorageData 公共存储数据
+type StorageData struct {
+	ID          string          `json:"id,omitempty"`           // ID
+	WorkspaceID string          `json:"workspace_id,omitempty"` // 项目ID
+	Name        string          `json:"name,omitempty"`         // 数据集名称
+	Data        json.RawMessage `json:"data,omitempty"`         // 数据内容，JSON 对象
+	Creator     string          `json:"creator,omitempty"`      // 创建人
+	Created     string          `json:"created,omitempty"`      // 创建时间
+	Modified    string          `json:"modified,omitempty"`     // 最后修改时间
+}
+
+// Decode decodes the stored data into v.
+func (d *StorageData) Decode(v any) error {
+	return json.Unmarshal(d.Data, v)
+}
+
+// StorageService 公共存储服务
+//
+// 查询、更新和删除数据时可以用 storage 包构造条件，如：
+//
+//	storage.Where("status").Eq("done").And(storage.Where("priority").Gt(3))
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/storage/
+type StorageService struct {
+	client *Client
+}
+
+// -----------------------------------------------------------------------------
+// 保存数据
+// -----------------------------------------------------------------------------
+
+type SaveStorageDataRequest struct {
+	WorkspaceID *int    `json:"workspace_id,omitempty"` // [必须]项目ID
+	Name        *string `json:"name,omitempty"`         // [必须]数据集名称
+	Data        any     `json:"data,omitempty"`         // [必须]数据内容，编码为 JSON 对象
+	Creator     *string `json:"creator,omitempty"`      // 创建人
+}
+
+// SaveData 保存数据
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/storage/save.html
+func (s *StorageService) SaveData(
+	ctx context.Context, request *SaveStorageDataRequest, opts ...RequestOption,
+) (*StorageData, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "storages", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var item struct {
+		Storage *StorageData `json:"Storage"`
+	}
+	resp, err := s.client.Do(req, &item)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return item.Storage, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 查询数据
+// -----------------------------------------------------------------------------
+
+type GetStorageDataRequest struct {
+	WorkspaceID *int               `url:"workspace_id,omitempty"` // [必须]项目ID
+	Name        *string            `url:"name,omitempty"`         // [必须]数据集名称
+	ID          *Multi[int64]      `url:"id,omitempty"`           // ID	支持多ID查询
+	Condition   *storage.Condition `url:"condition,omitempty"`    // 查询条件，见条件语法
+	Limit       *int               `url:"limit,omitempty"`        // 设置返回数量限制，默认为30
+	Page        *int               `url:"page,omitempty"`         // 返回当前数量限制下第N页的数据，默认为1（第一页）
+	Order       *Order             `url:"order,omitempty"`        // 排序规则，规则：字段名 ASC或者DESC
+}
+
+// GetData 查询数据
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/storage/query.html
+func (s *StorageService) GetData(
+	ctx context.Context, request *GetStorageDataRequest, opts ...RequestOption,
+) ([]*StorageData, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "storages", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var items []struct {
+		Storage *StorageData `json:"Storage"`
+	}
+	resp, err := s.client.Do(req, &items)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	data := make([]*StorageData, 0, len(items))
+	for _, item := range items {
+		data = append(data, item.Storage)
+	}
+
+	return data, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 更新数据
+// -----------------------------------------------------------------------------
+
+type UpdateStorageDataRequest struct {
+	WorkspaceID *int               `json:"workspace_id,omitempty"` // [必须]项目ID
+	Name        *string            `json:"name,omitempty"`         // [必须]数据集名称
+	ID          *int64             `json:"id,omitempty"`           // ID，与 Condition 二选一
+	Condition   *storage.Condition `json:"condition,omitempty"`    // 更新条件，见条件语法
+	Data        any                `json:"data,omitempty"`         // [必须]要更新的字段，编码为 JSON 对象
+}
+
+// UpdateData 更新数据，返回更新的数据条数
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/storage/update.html
+func (s *StorageService) UpdateData(
+	ctx context.Context, request *UpdateStorageDataRequest, opts ...RequestOption,
+) (int, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "storages/update", request, opts)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var response CountResponse
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return 0, resp, err
+	}
+
+	return response.Count, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 删除数据
+// -----------------------------------------------------------------------------
+
+type DeleteStorageDataRequest struct {
+	WorkspaceID *int               `json:"workspace_id,omitempty"` // [必须]项目ID
+	Name        *string            `json:"name,omitempty"`         // [必须]数据集名称
+	ID          *int64             `json:"id,omitempty"`           // ID，与 Condition 二选一
+	Condition   *storage.Condition `json:"condition,omitempty"`    // 删除条件，见条件语法
+}
+
+// DeleteData 删除数据，返回删除的数据条数
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/storage/delete.html
+func (s *StorageService) DeleteData(
+	ctx context.Context, request *DeleteStorageDataRequest, opts ...RequestOption,
+) (int, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "storages/delete", request, opts)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var response CountResponse
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return 0, resp, err
+	}
+
+	return response.Count, resp, nil
+}
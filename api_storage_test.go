@@ -0,0 +1,139 @@
+package tapd
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/go-tapd/tapd/storage"
+)
+
+type oncallState struct {
+	Status   string `json:"status"`
+	Priority int    `json:"priority"`
+	Owner    string `json:"owner"`
+}
+
+func TestStorageService_SaveData(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/storages", r.URL.Path)
+
+		var req struct {
+			WorkspaceID int         `json:"workspace_id"`
+			Name        string      `json:"name"`
+			Data        oncallState `json:"data"`
+			Creator     string      `json:"creator"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, 11112222, req.WorkspaceID)
+		assert.Equal(t, "oncall_state", req.Name)
+		assert.Equal(t, oncallState{Status: "done", Priority: 3, Owner: "alice"}, req.Data)
+		assert.Equal(t, "oncall-bot", req.Creator)
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/storage/save_data.json"))
+	}))
+
+	data, _, err := client.StorageService.SaveData(ctx, &SaveStorageDataRequest{
+		WorkspaceID: Ptr(11112222),
+		Name:        Ptr("oncall_state"),
+		Data:        oncallState{Status: "done", Priority: 3, Owner: "alice"},
+		Creator:     Ptr("oncall-bot"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "1111112222001000181", data.ID)
+	assert.Equal(t, "11112222", data.WorkspaceID)
+	assert.Equal(t, "oncall_state", data.Name)
+
+	var state oncallState
+	require.NoError(t, data.Decode(&state))
+	assert.Equal(t, oncallState{Status: "done", Priority: 3, Owner: "alice"}, state)
+}
+
+func TestStorageService_GetData(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/storages", r.URL.Path)
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+		assert.Equal(t, "oncall_state", r.URL.Query().Get("name"))
+		assert.Equal(t, `status = "done" AND priority > 2`, r.URL.Query().Get("condition"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/storage/get_data.json"))
+	}))
+
+	data, _, err := client.StorageService.GetData(ctx, &GetStorageDataRequest{
+		WorkspaceID: Ptr(11112222),
+		Name:        Ptr("oncall_state"),
+		Condition:   storage.Where("status").Eq("done").And(storage.Where("priority").Gt(2)),
+	})
+	require.NoError(t, err)
+	require.Len(t, data, 2)
+	assert.Equal(t, "1111112222001000181", data[0].ID)
+	assert.Equal(t, "1111112222001000182", data[1].ID)
+
+	var state oncallState
+	require.NoError(t, data[1].Decode(&state))
+	assert.Equal(t, oncallState{Status: "done", Priority: 5, Owner: "bob"}, state)
+}
+
+func TestStorageService_UpdateData(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/storages/update", r.URL.Path)
+
+		var req struct {
+			WorkspaceID int               `json:"workspace_id"`
+			Name        string            `json:"name"`
+			Condition   string            `json:"condition"`
+			Data        map[string]string `json:"data"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, 11112222, req.WorkspaceID)
+		assert.Equal(t, "oncall_state", req.Name)
+		assert.Equal(t, `owner IN ["alice","bob"]`, req.Condition)
+		assert.Equal(t, map[string]string{"status": "closed"}, req.Data)
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/storage/update_data.json"))
+	}))
+
+	count, _, err := client.StorageService.UpdateData(ctx, &UpdateStorageDataRequest{
+		WorkspaceID: Ptr(11112222),
+		Name:        Ptr("oncall_state"),
+		Condition:   storage.Where("owner").In("alice", "bob"),
+		Data:        map[string]string{"status": "closed"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+}
+
+func TestStorageService_DeleteData(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/storages/delete", r.URL.Path)
+
+		var req struct {
+			WorkspaceID int     `json:"workspace_id"`
+			Name        string  `json:"name"`
+			ID          int64   `json:"id"`
+			Condition   *string `json:"condition"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, 11112222, req.WorkspaceID)
+		assert.Equal(t, "oncall_state", req.Name)
+		assert.Equal(t, int64(1111112222001000181), req.ID)
+		assert.Nil(t, req.Condition)
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/storage/delete_data.json"))
+	}))
+
+	count, _, err := client.StorageService.DeleteData(ctx, &DeleteStorageDataRequest{
+		WorkspaceID: Ptr(11112222),
+		Name:        Ptr("oncall_state"),
+		ID:          Ptr[int64](1111112222001000181),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+}
@@ -97,7 +97,56 @@ type CreateStoryRequest struct {
 
 // 创建需求分类
 // 复制需求
+
+// -----------------------------------------------------------------------------
 // 获取需求与其它需求的所有关联关系
+// -----------------------------------------------------------------------------
+
+// GetStoryRelations 获取需求与其它需求的所有关联关系
+//
+// 返回父子关系、前后置关系以及普通关联关系。
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/story/get_related_stories.html
+func (s *StoryService) GetStoryRelations(
+	ctx context.Context, request *GetStoryRelationsRequest, opts ...RequestOption,
+) ([]*StoryRelation, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "stories/get_related_stories", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var relations []*StoryRelation
+	resp, err := s.client.Do(req, &relations)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return relations, resp, nil
+}
+
+type GetStoryRelationsRequest struct {
+	WorkspaceID *int   `url:"workspace_id,omitempty"` // [必须]项目ID
+	StoryID     *int64 `url:"story_id,omitempty"`     // [必须]需求ID
+}
+
+// StoryRelationType 需求关联关系类型
+type StoryRelationType string
+
+const (
+	StoryRelationTypeParent StoryRelationType = "parent" // 父需求
+	StoryRelationTypeChild  StoryRelationType = "child"  // 子需求
+	StoryRelationTypePre    StoryRelationType = "pre"    // 前置需求
+	StoryRelationTypePost   StoryRelationType = "post"   // 后置需求
+	StoryRelationTypeRelate StoryRelationType = "relate" // 关联需求
+)
+
+// StoryRelation 需求与其它需求的关联关系
+type StoryRelation struct {
+	WorkspaceID    string            `json:"workspace_id,omitempty"`     // 项目ID
+	StoryID        string            `json:"story_id,omitempty"`         // 需求ID
+	RelatedStoryID string            `json:"related_story_id,omitempty"` // 关联需求ID
+	RelationType   StoryRelationType `json:"relation_type,omitempty"`    // 关联需求相对于需求的关系
+}
 
 type Story struct {
 	ID                string        `json:"id,omitempty"`
@@ -357,6 +406,8 @@ type Story struct {
 	CustomPlanField8  string        `json:"custom_plan_field_8,omitempty"`
 	CustomPlanField9  string        `json:"custom_plan_field_9,omitempty"`
 	CustomPlanField10 string        `json:"custom_plan_field_10,omitempty"`
+
+	customFields *CustomFields // BindCustomFields 绑定的自定义字段
 }
 
 // GetStories 获取需求
@@ -406,12 +457,12 @@ type GetStoriesRequest struct {
 	CC                *string            `url:"cc,omitempty"`               // 抄送人	支持模糊匹配
 	Creator           *string            `url:"creator,omitempty"`          // 创建人	支持多人员查询
 	Developer         *string            `url:"developer,omitempty"`        // 开发人员
-	Begin             *string            `url:"begin,omitempty"`            // 预计开始	支持时间查询
-	Due               *string            `url:"due,omitempty"`              // 预计结束	支持时间查询
-	Created           *string            `url:"created,omitempty"`          // 创建时间	支持时间查询
-	Modified          *string            `url:"modified,omitempty"`         // 最后修改时间	支持时间查询
-	Completed         *string            `url:"completed,omitempty"`        // 完成时间	支持时间查询
-	IterationID       *string            `url:"iteration_id,omitempty"`     // 迭代ID	支持不等于查询
+	Begin             *TimeQuery         `url:"begin,omitempty"`            // 预计开始	支持时间查询
+	Due               *TimeQuery         `url:"due,omitempty"`              // 预计结束	支持时间查询
+	Created           *TimeQuery         `url:"created,omitempty"`          // 创建时间	支持时间查询
+	Modified          *TimeQuery         `url:"modified,omitempty"`         // 最后修改时间	支持时间查询
+	Completed         *TimeQuery         `url:"completed,omitempty"`        // 完成时间	支持时间查询
+	IterationID       *Query             `url:"iteration_id,omitempty"`     // 迭代ID	支持不等于查询
 	Effort            *string            `url:"effort,omitempty"`           // 预估工时
 	EffortCompleted   *string            `url:"effort_completed,omitempty"` // 完成工时
 	Remain            *float64           `url:"remain,omitempty"`           // 剩余工时
@@ -682,12 +733,12 @@ type GetStoriesCountRequest struct {
 	CC                *string        `url:"cc,omitempty"`               // 抄送人	支持模糊匹配
 	Creator           *string        `url:"creator,omitempty"`          // 创建人	支持多人员查询
 	Developer         *string        `url:"developer,omitempty"`        // 开发人员
-	Begin             *string        `url:"begin,omitempty"`            // 预计开始	支持时间查询
-	Due               *string        `url:"due,omitempty"`              // 预计结束	支持时间查询
-	Created           *string        `url:"created,omitempty"`          // 创建时间	支持时间查询
-	Modified          *string        `url:"modified,omitempty"`         // 最后修改时间	支持时间查询
-	Completed         *string        `url:"completed,omitempty"`        // 完成时间	支持时间查询
-	IterationID       *string        `url:"iteration_id,omitempty"`     // 迭代ID	支持不等于查询
+	Begin             *TimeQuery     `url:"begin,omitempty"`            // 预计开始	支持时间查询
+	Due               *TimeQuery     `url:"due,omitempty"`              // 预计结束	支持时间查询
+	Created           *TimeQuery     `url:"created,omitempty"`          // 创建时间	支持时间查询
+	Modified          *TimeQuery     `url:"modified,omitempty"`         // 最后修改时间	支持时间查询
+	Completed         *TimeQuery     `url:"completed,omitempty"`        // 完成时间	支持时间查询
+	IterationID       *Query         `url:"iteration_id,omitempty"`     // 迭代ID	支持不等于查询
 	Effort            *string        `url:"effort,omitempty"`           // 预估工时
 	EffortCompleted   *string        `url:"effort_completed,omitempty"` // 完成工时
 	Remain            *float64       `url:"remain,omitempty"`           // 剩余工时
@@ -912,8 +963,66 @@ type GetStoriesCountRequest struct {
 	CustomPlanField10 *string        `url:"custom_plan_field_10,omitempty"`
 }
 
+// -----------------------------------------------------------------------------
 // 获取保密需求
+// -----------------------------------------------------------------------------
+
+// GetSecretStories 获取保密需求
+//
+// 查询条件与 GetStories 相同，只返回当前用户可见的保密需求。
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/story/get_secret_stories.html
+func (s *StoryService) GetSecretStories(
+	ctx context.Context, request *GetSecretStoriesRequest, opts ...RequestOption,
+) ([]*Story, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "stories/secret", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var items []struct {
+		Story *Story `json:"Story"`
+	}
+	resp, err := s.client.Do(req, &items)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	stories := make([]*Story, 0, len(items))
+	for _, item := range items {
+		stories = append(stories, item.Story)
+	}
+
+	return stories, resp, nil
+}
+
+type GetSecretStoriesRequest = GetStoriesRequest
+
+// -----------------------------------------------------------------------------
 // 获取保密需求数量
+// -----------------------------------------------------------------------------
+
+// GetSecretStoriesCount 获取保密需求数量
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/story/get_secret_stories_count.html
+func (s *StoryService) GetSecretStoriesCount(
+	ctx context.Context, request *GetSecretStoriesCountRequest, opts ...RequestOption,
+) (int, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "stories/secret/count", request, opts)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var response CountResponse
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return 0, resp, err
+	}
+
+	return response.Count, resp, nil
+}
+
+type GetSecretStoriesCountRequest = GetStoriesCountRequest
 
 // GetStoryCategories 获取需求分类
 //
@@ -948,8 +1057,8 @@ type GetStoryCategoriesRequest struct {
 	Name        *string        `url:"name,omitempty"`         // 需求分类名称	支持模糊匹配
 	Description *string        `url:"description,omitempty"`  // 需求分类描述
 	ParentID    *int           `url:"parent_id,omitempty"`    // 父分类ID
-	Created     *string        `url:"created,omitempty"`      // 创建时间	支持时间查询
-	Modified    *string        `url:"modified,omitempty"`     // 最后修改时间	支持时间查询
+	Created     *TimeQuery     `url:"created,omitempty"`      // 创建时间	支持时间查询
+	Modified    *TimeQuery     `url:"modified,omitempty"`     // 最后修改时间	支持时间查询
 	Limit       *int           `url:"limit,omitempty"`        // 设置返回数量限制，默认为30
 	Page        *int           `url:"page,omitempty"`         // 返回当前数量限制下第N页的数据，默认为1（第一页）
 	Order       *Order         `url:"order,omitempty"`        //nolint:lll // 排序规则，规则：字段名 ASC或者DESC，然后 urlencode	如按创建时间逆序：order=created%20desc
@@ -978,8 +1087,8 @@ type GetStoryCategoriesCountRequest struct {
 	Name        *string       `url:"name,omitempty"`         // 需求分类名称	支持模糊匹配
 	Description *string       `url:"description,omitempty"`  // 需求分类描述
 	ParentID    *int          `url:"parent_id,omitempty"`    // 父分类ID
-	Created     *string       `url:"created,omitempty"`      // 创建时间	支持时间查询
-	Modified    *string       `url:"modified,omitempty"`     // 最后修改时间	支持时间查询
+	Created     *TimeQuery    `url:"created,omitempty"`      // 创建时间	支持时间查询
+	Modified    *TimeQuery    `url:"modified,omitempty"`     // 最后修改时间	支持时间查询
 }
 
 // GetStoryCategoriesCount 获取需求分类数量
@@ -1101,7 +1210,7 @@ type GetStoryChangesRequest struct {
 	StoryID          *Multi[int64]    `url:"story_id,omitempty"`           // 需求id	支持多ID查询
 	WorkspaceID      *int             `url:"workspace_id,omitempty"`       // [必须]项目ID
 	Creator          *string          `url:"creator,omitempty"`            // 创建人（操作人）
-	Created          *string          `url:"created,omitempty"`            // 创建时间（变更时间）	支持时间查询
+	Created          *TimeQuery       `url:"created,omitempty"`            // 创建时间（变更时间）	支持时间查询
 	ChangeType       *StoreChangeType `url:"change_type,omitempty"`        // 变更类型
 	ChangeSummary    *string          `url:"change_summary,omitempty"`     // 需求变更描述
 	Comment          *string          `url:"comment,omitempty"`            // 评论
@@ -1198,26 +1307,230 @@ type StoryCustomFieldsSetting struct {
 // 获取需求与测试用例关联关系
 // -----------------------------------------------------------------------------
 
+// GetStoryTestCaseRelations 获取需求与测试用例关联关系
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/story/get_story_tcase.html
+func (s *StoryService) GetStoryTestCaseRelations(
+	ctx context.Context, request *GetStoryTestCaseRelationsRequest, opts ...RequestOption,
+) ([]*StoryTestCaseRelation, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "stories/get_related_tcases", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var relations []*StoryTestCaseRelation
+	resp, err := s.client.Do(req, &relations)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return relations, resp, nil
+}
+
+type GetStoryTestCaseRelationsRequest struct {
+	WorkspaceID *int          `url:"workspace_id,omitempty"` // [必须]项目ID
+	StoryID     *Multi[int64] `url:"story_id,omitempty"`     // [必须]需求ID，支持多ID查询
+}
+
+// StoryTestCaseRelation 需求与测试用例关联关系
+type StoryTestCaseRelation struct {
+	WorkspaceID string `json:"workspace_id,omitempty"` // 项目ID
+	StoryID     string `json:"story_id,omitempty"`     // 需求ID
+	TestCaseID  string `json:"tcase_id,omitempty"`     // 测试用例ID
+}
+
 // -----------------------------------------------------------------------------
 // 获取需求前后置关系
 // -----------------------------------------------------------------------------
 
+// StoryDependencyType 需求前后置关系类型
+type StoryDependencyType string
+
+const (
+	StoryDependencyTypeFinishToStart  StoryDependencyType = "FS" // 完成-开始
+	StoryDependencyTypeStartToStart   StoryDependencyType = "SS" // 开始-开始
+	StoryDependencyTypeFinishToFinish StoryDependencyType = "FF" // 完成-完成
+	StoryDependencyTypeStartToFinish  StoryDependencyType = "SF" // 开始-完成
+)
+
+// StoryDependency 需求前后置关系
+type StoryDependency struct {
+	ID          string              `json:"id,omitempty"`            // ID
+	WorkspaceID string              `json:"workspace_id,omitempty"`  // 项目ID
+	PreStoryID  string              `json:"pre_story_id,omitempty"`  // 前置需求ID
+	PostStoryID string              `json:"post_story_id,omitempty"` // 后置需求ID
+	Type        StoryDependencyType `json:"type,omitempty"`          // 前后置关系类型
+	Creator     string              `json:"creator,omitempty"`       // 创建人
+	Created     string              `json:"created,omitempty"`       // 创建时间
+	Modified    string              `json:"modified,omitempty"`      // 最后修改时间
+}
+
+// GetStoryDependencies 获取需求前后置关系
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/story/get_story_dependence.html
+func (s *StoryService) GetStoryDependencies(
+	ctx context.Context, request *GetStoryDependenciesRequest, opts ...RequestOption,
+) ([]*StoryDependency, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "stories/get_dependence_relations", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var dependencies []*StoryDependency
+	resp, err := s.client.Do(req, &dependencies)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return dependencies, resp, nil
+}
+
+type GetStoryDependenciesRequest struct {
+	WorkspaceID *int          `url:"workspace_id,omitempty"` // [必须]项目ID
+	StoryID     *Multi[int64] `url:"story_id,omitempty"`     // [必须]需求ID，返回以这些需求为前置或后置的关系
+}
+
 // -----------------------------------------------------------------------------
 // 批量新增或修改需求前后置关系
 // -----------------------------------------------------------------------------
 
+// BatchSaveStoryDependencies 批量新增或修改需求前后置关系
+//
+// 前置需求和后置需求之间已有关系时修改关系类型，否则新增。
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/story/batch_save_story_dependence.html
+func (s *StoryService) BatchSaveStoryDependencies(
+	ctx context.Context, request *BatchSaveStoryDependenciesRequest, opts ...RequestOption,
+) ([]*StoryDependency, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "stories/batch_save_dependence_relations", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var dependencies []*StoryDependency
+	resp, err := s.client.Do(req, &dependencies)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return dependencies, resp, nil
+}
+
+type BatchSaveStoryDependenciesRequest struct {
+	WorkspaceID  *int                   `json:"workspace_id,omitempty"` // [必须]项目ID
+	Dependencies []*StoryDependencyItem `json:"relations,omitempty"`    // [必须]前后置关系
+	Creator      *string                `json:"creator,omitempty"`      // 创建人
+}
+
+// StoryDependencyItem 批量新增或修改的一条需求前后置关系
+type StoryDependencyItem struct {
+	PreStoryID  *int64               `json:"pre_story_id,omitempty"`  // [必须]前置需求ID
+	PostStoryID *int64               `json:"post_story_id,omitempty"` // [必须]后置需求ID
+	Type        *StoryDependencyType `json:"type,omitempty"`          // 前后置关系类型，默认为完成-开始
+}
+
 // -----------------------------------------------------------------------------
 // 批量删除需求前后置关系
 // -----------------------------------------------------------------------------
 
+// BatchDeleteStoryDependencies 批量删除需求前后置关系
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/story/batch_delete_story_dependence.html
+func (s *StoryService) BatchDeleteStoryDependencies(
+	ctx context.Context, request *BatchDeleteStoryDependenciesRequest, opts ...RequestOption,
+) (*Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "stories/batch_delete_dependence_relations", request, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+type BatchDeleteStoryDependenciesRequest struct {
+	WorkspaceID *int          `json:"workspace_id,omitempty"` // [必须]项目ID
+	ID          *Multi[int64] `json:"ids,omitempty"`          // [必须]前后置关系ID
+}
+
 // -----------------------------------------------------------------------------
 // 获取需求保密信息
 // -----------------------------------------------------------------------------
 
+// StorySecretInfo 需求保密信息
+type StorySecretInfo struct {
+	StoryID      string `json:"story_id,omitempty"`       // 需求ID
+	WorkspaceID  string `json:"workspace_id,omitempty"`   // 项目ID
+	IsSecret     string `json:"is_secret,omitempty"`      // 是否保密，1 为保密
+	SecretRootID string `json:"secret_root_id,omitempty"` // 保密根需求ID，子需求继承父需求的保密设置
+	Users        string `json:"users,omitempty"`          // 可见人员，多个以分号分隔
+	UserGroups   string `json:"user_groups,omitempty"`    // 可见用户组ID，多个以逗号分隔
+}
+
+// GetStorySecretInfo 获取需求保密信息
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/story/get_story_secret_info.html
+func (s *StoryService) GetStorySecretInfo(
+	ctx context.Context, request *GetStorySecretInfoRequest, opts ...RequestOption,
+) ([]*StorySecretInfo, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "stories/get_secret_info", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var items []struct {
+		SecretInfo *StorySecretInfo `json:"SecretInfo"`
+	}
+	resp, err := s.client.Do(req, &items)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	infos := make([]*StorySecretInfo, 0, len(items))
+	for _, item := range items {
+		infos = append(infos, item.SecretInfo)
+	}
+
+	return infos, resp, nil
+}
+
+type GetStorySecretInfoRequest struct {
+	WorkspaceID *int          `url:"workspace_id,omitempty"` // [必须]项目ID
+	StoryID     *Multi[int64] `url:"story_id,omitempty"`     // [必须]需求ID，支持多ID查询
+}
+
 // -----------------------------------------------------------------------------
 // 批量修改保密信息
 // -----------------------------------------------------------------------------
 
+// BatchUpdateSecretInfo 批量修改保密信息，返回修改的需求数量
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/story/batch_update_secret_info.html
+func (s *StoryService) BatchUpdateSecretInfo(
+	ctx context.Context, request *BatchUpdateSecretInfoRequest, opts ...RequestOption,
+) (int, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "stories/batch_update_secret_info", request, opts)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var response CountResponse
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return 0, resp, err
+	}
+
+	return response.Count, resp, nil
+}
+
+type BatchUpdateSecretInfoRequest struct {
+	WorkspaceID *int          `json:"workspace_id,omitempty"` // [必须]项目ID
+	StoryID     *Multi[int64] `json:"story_id,omitempty"`     // [必须]需求ID
+	IsSecret    *int          `json:"is_secret,omitempty"`    // [必须]是否保密，1 为保密，0 为取消保密
+	Users       *Users        `json:"users,omitempty"`        // 可见人员，以分号分隔
+	UserGroups  *Multi[int64] `json:"user_groups,omitempty"`  // 可见用户组ID
+	CurrentUser *string       `json:"current_user,omitempty"` // 变更人
+}
+
 // GetWorkitemTypes 获取需求类别
 // https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/story/get_workitem_types.html
 func (s *StoryService) GetWorkitemTypes(
@@ -1537,7 +1850,10 @@ func (s *StoryService) GetStoryFieldsInfo(
 		return nil, resp, err
 	}
 
-	fields := convertRawFieldsInfo(raw)
+	fields, err := convertRawFieldsInfo(raw)
+	if err != nil {
+		return nil, resp, err
+	}
 	return fields, resp, nil
 }
 
@@ -1718,18 +2034,121 @@ type StoryRelatedBug struct {
 // 解除需求缺陷关联关系
 // -----------------------------------------------------------------------------
 
+// RemoveStoryBugRelations 解除需求缺陷关联关系
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/story/remove_story_bug_relation.html
+func (s *StoryService) RemoveStoryBugRelations(
+	ctx context.Context, request *RemoveStoryBugRelationsRequest, opts ...RequestOption,
+) (*Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "stories/remove_related_bugs", request, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+type RemoveStoryBugRelationsRequest struct {
+	WorkspaceID *int          `json:"workspace_id,omitempty"` // [必须]项目ID
+	StoryID     *int64        `json:"story_id,omitempty"`     // [必须]需求ID
+	BugID       *Multi[int64] `json:"bug_id,omitempty"`       // [必须]缺陷ID
+}
+
 // -----------------------------------------------------------------------------
 // 更新父需求
 // -----------------------------------------------------------------------------
 
+// UpdateParentStory 更新父需求
+//
+// ParentID 传 0 时将需求移出原父需求，成为顶层需求。
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/story/update_parent_story.html
+func (s *StoryService) UpdateParentStory(
+	ctx context.Context, request *UpdateParentStoryRequest, opts ...RequestOption,
+) (*Story, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "stories/update_parent_story", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var item struct {
+		Story *Story `json:"Story"`
+	}
+	resp, err := s.client.Do(req, &item)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return item.Story, resp, nil
+}
+
+type UpdateParentStoryRequest struct {
+	WorkspaceID *int    `json:"workspace_id,omitempty"` // [必须]项目ID
+	ID          *int64  `json:"id,omitempty"`           // [必须]需求ID
+	ParentID    *int64  `json:"parent_id,omitempty"`    // [必须]父需求ID
+	CurrentUser *string `json:"current_user,omitempty"` // 变更人
+}
+
 // -----------------------------------------------------------------------------
 // 创建需求与缺陷关联关系
 // -----------------------------------------------------------------------------
 
+// CreateStoryBugRelations 创建需求与缺陷关联关系
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/story/create_story_bug_relation.html
+func (s *StoryService) CreateStoryBugRelations(
+	ctx context.Context, request *CreateStoryBugRelationsRequest, opts ...RequestOption,
+) ([]*StoryRelatedBug, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "stories/create_related_bugs", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var bugs []*StoryRelatedBug
+	resp, err := s.client.Do(req, &bugs)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return bugs, resp, nil
+}
+
+type CreateStoryBugRelationsRequest struct {
+	WorkspaceID *int          `json:"workspace_id,omitempty"` // [必须]项目ID
+	StoryID     *int64        `json:"story_id,omitempty"`     // [必须]需求ID
+	BugID       *Multi[int64] `json:"bug_id,omitempty"`       // [必须]缺陷ID
+}
+
 // -----------------------------------------------------------------------------
 // 创建需求与测试用例关联关系
 // -----------------------------------------------------------------------------
 
+// CreateStoryTestCaseRelations 创建需求与测试用例关联关系
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/story/create_story_tcase_relation.html
+func (s *StoryService) CreateStoryTestCaseRelations(
+	ctx context.Context, request *CreateStoryTestCaseRelationsRequest, opts ...RequestOption,
+) ([]*StoryTestCaseRelation, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "stories/create_related_tcases", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var relations []*StoryTestCaseRelation
+	resp, err := s.client.Do(req, &relations)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return relations, resp, nil
+}
+
+type CreateStoryTestCaseRelationsRequest struct {
+	WorkspaceID *int          `json:"workspace_id,omitempty"` // [必须]项目ID
+	StoryID     *int64        `json:"story_id,omitempty"`     // [必须]需求ID
+	TestCaseID  *Multi[int64] `json:"tcase_id,omitempty"`     // [必须]测试用例ID
+}
+
 // -----------------------------------------------------------------------------
 // 获取视图对应的需求列表
 // -----------------------------------------------------------------------------
@@ -34,8 +34,8 @@ func TestStoryService_GetStoryCategories(t *testing.T) {
 		Name:        Ptr("test name"),
 		Description: Ptr("test description"),
 		ParentID:    Ptr(1111111111111),
-		Created:     Ptr("2021-01-01"),
-		Modified:    Ptr("2021-01-02"),
+		Created:     NewTimeQuery("2021-01-01"),
+		Modified:    NewTimeQuery("2021-01-02"),
 		Limit:       Ptr(10),
 		Page:        Ptr(1),
 		Order:       NewOrder("id", OrderByAsc),
@@ -76,8 +76,8 @@ func TestStoryService_GetStoryCategoriesCount(t *testing.T) {
 		Name:        Ptr("test name"),
 		Description: Ptr("test description"),
 		ParentID:    Ptr(1111111111111),
-		Created:     Ptr("2021-01-01"),
-		Modified:    Ptr("2021-01-02"),
+		Created:     NewTimeQuery("2021-01-01"),
+		Modified:    NewTimeQuery("2021-01-02"),
 	})
 	assert.NoError(t, err)
 	assert.Equal(t, 30, count)
@@ -213,8 +213,8 @@ func TestStoryService_GetStoryTemplates(t *testing.T) {
 	}))
 
 	templates, _, err := client.StoryService.GetStoryTemplates(ctx, &GetStoryTemplatesRequest{
-		WorkspaceID:    Ptr(11112222),
-		WorkitemTypeID: Ptr(1),
+		WorkspaceID:    Ptr[int64](11112222),
+		WorkitemTypeID: Ptr[int64](1),
 	})
 	assert.NoError(t, err)
 	assert.True(t, len(templates) > 0)
@@ -239,7 +239,7 @@ func TestStoryService_GetStoryTemplateFields(t *testing.T) {
 	}))
 
 	fields, _, err := client.StoryService.GetStoryTemplateFields(ctx, &GetStoryTemplateFieldsRequest{
-		WorkspaceID: Ptr(11112222),
+		WorkspaceID: Ptr[int64](11112222),
 		TemplateID:  Ptr(int64(1111111111111)),
 	})
 	assert.NoError(t, err)
@@ -317,3 +317,353 @@ func TestStoryService_GetConvertStoryIDsToQueryToken(t *testing.T) {
 	assert.Equal(t, "11111111111", response.QueryToken)
 	assert.Contains(t, response.Href, "11111111111")
 }
+
+func TestStoryService_GetStoryRelations(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/stories/get_related_stories", r.URL.Path)
+
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+		assert.Equal(t, "1111112222001000302", r.URL.Query().Get("story_id"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/story/get_story_relations.json"))
+	}))
+
+	relations, _, err := client.StoryService.GetStoryRelations(ctx, &GetStoryRelationsRequest{
+		WorkspaceID: Ptr(11112222),
+		StoryID:     Ptr[int64](1111112222001000302),
+	})
+	assert.NoError(t, err)
+	assert.Len(t, relations, 3)
+	assert.Equal(t, "1111112222001000301", relations[0].RelatedStoryID)
+	assert.Equal(t, StoryRelationTypeParent, relations[0].RelationType)
+	assert.Equal(t, StoryRelationTypeChild, relations[1].RelationType)
+	assert.Equal(t, StoryRelationTypePost, relations[2].RelationType)
+}
+
+func TestStoryService_GetStoryTestCaseRelations(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/stories/get_related_tcases", r.URL.Path)
+
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+		assert.Equal(t, "1111112222001000302", r.URL.Query().Get("story_id"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/story/get_story_test_case_relations.json"))
+	}))
+
+	relations, _, err := client.StoryService.GetStoryTestCaseRelations(ctx, &GetStoryTestCaseRelationsRequest{
+		WorkspaceID: Ptr(11112222),
+		StoryID:     NewMulti[int64](1111112222001000302),
+	})
+	assert.NoError(t, err)
+	assert.Len(t, relations, 2)
+	assert.Equal(t, "1111112222001000302", relations[0].StoryID)
+	assert.Equal(t, "1111112222001000311", relations[0].TestCaseID)
+}
+
+func TestStoryService_GetStoryDependencies(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/stories/get_dependence_relations", r.URL.Path)
+
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+		assert.Equal(t, "1111112222001000302,1111112222001000304", r.URL.Query().Get("story_id"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/story/get_story_dependencies.json"))
+	}))
+
+	dependencies, _, err := client.StoryService.GetStoryDependencies(ctx, &GetStoryDependenciesRequest{
+		WorkspaceID: Ptr(11112222),
+		StoryID:     NewMulti[int64](1111112222001000302, 1111112222001000304),
+	})
+	assert.NoError(t, err)
+	assert.Len(t, dependencies, 1)
+	assert.Equal(t, "1111112222001000321", dependencies[0].ID)
+	assert.Equal(t, "1111112222001000302", dependencies[0].PreStoryID)
+	assert.Equal(t, "1111112222001000304", dependencies[0].PostStoryID)
+	assert.Equal(t, StoryDependencyTypeFinishToStart, dependencies[0].Type)
+}
+
+func TestStoryService_BatchSaveStoryDependencies(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/stories/batch_save_dependence_relations", r.URL.Path)
+
+		var req struct {
+			WorkspaceID int `json:"workspace_id"`
+			Relations   []struct {
+				PreStoryID  int64  `json:"pre_story_id"`
+				PostStoryID int64  `json:"post_story_id"`
+				Type        string `json:"type"`
+			} `json:"relations"`
+			Creator string `json:"creator"`
+		}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, 11112222, req.WorkspaceID)
+		assert.Equal(t, "planner", req.Creator)
+		if assert.Len(t, req.Relations, 2) {
+			assert.Equal(t, int64(1111112222001000302), req.Relations[0].PreStoryID)
+			assert.Equal(t, int64(1111112222001000304), req.Relations[0].PostStoryID)
+			assert.Equal(t, "SS", req.Relations[0].Type)
+			assert.Equal(t, int64(1111112222001000303), req.Relations[1].PreStoryID)
+			assert.Equal(t, "", req.Relations[1].Type)
+		}
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/story/batch_save_story_dependencies.json"))
+	}))
+
+	dependencies, _, err := client.StoryService.BatchSaveStoryDependencies(ctx, &BatchSaveStoryDependenciesRequest{
+		WorkspaceID: Ptr(11112222),
+		Dependencies: []*StoryDependencyItem{
+			{
+				PreStoryID:  Ptr[int64](1111112222001000302),
+				PostStoryID: Ptr[int64](1111112222001000304),
+				Type:        Ptr(StoryDependencyTypeStartToStart),
+			},
+			{
+				PreStoryID:  Ptr[int64](1111112222001000303),
+				PostStoryID: Ptr[int64](1111112222001000304),
+			},
+		},
+		Creator: Ptr("planner"),
+	})
+	assert.NoError(t, err)
+	assert.Len(t, dependencies, 2)
+	assert.Equal(t, StoryDependencyTypeStartToStart, dependencies[0].Type)
+	assert.Equal(t, "1111112222001000322", dependencies[1].ID)
+	assert.Equal(t, StoryDependencyTypeFinishToStart, dependencies[1].Type)
+}
+
+func TestStoryService_BatchDeleteStoryDependencies(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/stories/batch_delete_dependence_relations", r.URL.Path)
+
+		var req struct {
+			WorkspaceID int    `json:"workspace_id"`
+			IDs         string `json:"ids"`
+		}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, 11112222, req.WorkspaceID)
+		assert.Equal(t, "1111112222001000321,1111112222001000322", req.IDs)
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/story/batch_delete_story_dependencies.json"))
+	}))
+
+	_, err := client.StoryService.BatchDeleteStoryDependencies(ctx, &BatchDeleteStoryDependenciesRequest{
+		WorkspaceID: Ptr(11112222),
+		ID:          NewMulti[int64](1111112222001000321, 1111112222001000322),
+	})
+	assert.NoError(t, err)
+}
+
+func TestStoryService_RemoveStoryBugRelations(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/stories/remove_related_bugs", r.URL.Path)
+
+		var req struct {
+			WorkspaceID int    `json:"workspace_id"`
+			StoryID     int64  `json:"story_id"`
+			BugID       string `json:"bug_id"`
+		}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, 11112222, req.WorkspaceID)
+		assert.Equal(t, int64(1111112222001000302), req.StoryID)
+		assert.Equal(t, "1111112222001000331", req.BugID)
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/story/remove_story_bug_relations.json"))
+	}))
+
+	_, err := client.StoryService.RemoveStoryBugRelations(ctx, &RemoveStoryBugRelationsRequest{
+		WorkspaceID: Ptr(11112222),
+		StoryID:     Ptr[int64](1111112222001000302),
+		BugID:       NewMulti[int64](1111112222001000331),
+	})
+	assert.NoError(t, err)
+}
+
+func TestStoryService_UpdateParentStory(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/stories/update_parent_story", r.URL.Path)
+
+		var req struct {
+			WorkspaceID int    `json:"workspace_id"`
+			ID          int64  `json:"id"`
+			ParentID    int64  `json:"parent_id"`
+			CurrentUser string `json:"current_user"`
+		}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, 11112222, req.WorkspaceID)
+		assert.Equal(t, int64(1111112222001000303), req.ID)
+		assert.Equal(t, int64(1111112222001000301), req.ParentID)
+		assert.Equal(t, "planner", req.CurrentUser)
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/story/update_parent_story.json"))
+	}))
+
+	story, _, err := client.StoryService.UpdateParentStory(ctx, &UpdateParentStoryRequest{
+		WorkspaceID: Ptr(11112222),
+		ID:          Ptr[int64](1111112222001000303),
+		ParentID:    Ptr[int64](1111112222001000301),
+		CurrentUser: Ptr("planner"),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "1111112222001000303", story.ID)
+	assert.Equal(t, "1111112222001000301", story.ParentID)
+	assert.Equal(t, "1111112222001000301", story.AncestorID)
+}
+
+func TestStoryService_CreateStoryBugRelations(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/stories/create_related_bugs", r.URL.Path)
+
+		var req struct {
+			WorkspaceID int    `json:"workspace_id"`
+			StoryID     int64  `json:"story_id"`
+			BugID       string `json:"bug_id"`
+		}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, 11112222, req.WorkspaceID)
+		assert.Equal(t, int64(1111112222001000302), req.StoryID)
+		assert.Equal(t, "1111112222001000331,1111112222001000332", req.BugID)
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/story/create_story_bug_relations.json"))
+	}))
+
+	relatedBugs, _, err := client.StoryService.CreateStoryBugRelations(ctx, &CreateStoryBugRelationsRequest{
+		WorkspaceID: Ptr(11112222),
+		StoryID:     Ptr[int64](1111112222001000302),
+		BugID:       NewMulti[int64](1111112222001000331, 1111112222001000332),
+	})
+	assert.NoError(t, err)
+	assert.Len(t, relatedBugs, 2)
+	assert.Equal(t, "1111112222001000302", relatedBugs[0].StoryID)
+	assert.Equal(t, "1111112222001000332", relatedBugs[1].BugID)
+}
+
+func TestStoryService_CreateStoryTestCaseRelations(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/stories/create_related_tcases", r.URL.Path)
+
+		var req struct {
+			WorkspaceID int    `json:"workspace_id"`
+			StoryID     int64  `json:"story_id"`
+			TestCaseID  string `json:"tcase_id"`
+		}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, 11112222, req.WorkspaceID)
+		assert.Equal(t, int64(1111112222001000302), req.StoryID)
+		assert.Equal(t, "1111112222001000313", req.TestCaseID)
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/story/create_story_test_case_relations.json"))
+	}))
+
+	relations, _, err := client.StoryService.CreateStoryTestCaseRelations(ctx, &CreateStoryTestCaseRelationsRequest{
+		WorkspaceID: Ptr(11112222),
+		StoryID:     Ptr[int64](1111112222001000302),
+		TestCaseID:  NewMulti[int64](1111112222001000313),
+	})
+	assert.NoError(t, err)
+	assert.Len(t, relations, 1)
+	assert.Equal(t, "1111112222001000313", relations[0].TestCaseID)
+}
+
+func TestStoryService_GetSecretStories(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/stories/secret", r.URL.Path)
+
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+		assert.Equal(t, "secops", r.URL.Query().Get("owner"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/story/get_secret_stories.json"))
+	}))
+
+	stories, _, err := client.StoryService.GetSecretStories(ctx, &GetSecretStoriesRequest{
+		WorkspaceID: Ptr[int64](11112222),
+		Owner:       Ptr("secops"),
+	})
+	assert.NoError(t, err)
+	assert.Len(t, stories, 2)
+	assert.Equal(t, "1111112222001000401", stories[0].ID)
+	assert.Equal(t, "[漏洞] 登录接口存在越权访问", stories[0].Name)
+	assert.Equal(t, "1111112222001000401", stories[0].SecretRootID)
+}
+
+func TestStoryService_GetSecretStoriesCount(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/stories/secret/count", r.URL.Path)
+
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/story/get_secret_stories_count.json"))
+	}))
+
+	count, _, err := client.StoryService.GetSecretStoriesCount(ctx, &GetSecretStoriesCountRequest{
+		WorkspaceID: Ptr(11112222),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, count)
+}
+
+func TestStoryService_GetStorySecretInfo(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/stories/get_secret_info", r.URL.Path)
+
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+		assert.Equal(t, "1111112222001000401", r.URL.Query().Get("story_id"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/story/get_story_secret_info.json"))
+	}))
+
+	infos, _, err := client.StoryService.GetStorySecretInfo(ctx, &GetStorySecretInfoRequest{
+		WorkspaceID: Ptr(11112222),
+		StoryID:     NewMulti[int64](1111112222001000401),
+	})
+	assert.NoError(t, err)
+	assert.Len(t, infos, 1)
+	assert.Equal(t, "1111112222001000401", infos[0].StoryID)
+	assert.Equal(t, "1", infos[0].IsSecret)
+	assert.Equal(t, "secops;alice;", infos[0].Users)
+	assert.Equal(t, "1111112222001000411", infos[0].UserGroups)
+}
+
+func TestStoryService_BatchUpdateSecretInfo(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/stories/batch_update_secret_info", r.URL.Path)
+
+		var req struct {
+			WorkspaceID int    `json:"workspace_id"`
+			StoryID     string `json:"story_id"`
+			IsSecret    int    `json:"is_secret"`
+			Users       string `json:"users"`
+			UserGroups  string `json:"user_groups"`
+		}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, 11112222, req.WorkspaceID)
+		assert.Equal(t, "1111112222001000401,1111112222001000402", req.StoryID)
+		assert.Equal(t, 1, req.IsSecret)
+		assert.Equal(t, "secops;alice;", req.Users)
+		assert.Equal(t, "1111112222001000411", req.UserGroups)
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/story/batch_update_secret_info.json"))
+	}))
+
+	count, _, err := client.StoryService.BatchUpdateSecretInfo(ctx, &BatchUpdateSecretInfoRequest{
+		WorkspaceID: Ptr(11112222),
+		StoryID:     NewMulti[int64](1111112222001000401, 1111112222001000402),
+		IsSecret:    Ptr(1),
+		Users:       Ptr(Users{"secops", "alice"}),
+		UserGroups:  NewMulti[int64](1111112222001000411),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, count)
+}
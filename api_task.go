@@ -109,6 +109,8 @@ type Task struct {
 	CustomPlanField9  string        `json:"custom_plan_field_9,omitempty"`
 	CustomPlanField10 string        `json:"custom_plan_field_10,omitempty"`
 	PriorityLabel     PriorityLabel `json:"priority_label,omitempty"` // 优先级
+
+	customFields *CustomFields // BindCustomFields 绑定的自定义字段
 }
 
 // TaskService 任务服务
@@ -203,7 +205,7 @@ type GetTaskChangesRequest struct {
 	WorkspaceID      *int           `url:"workspace_id,omitempty"`       // [必须]项目ID
 	TaskID           *int64         `url:"task_id,omitempty"`            // 任务ID
 	Creator          *string        `url:"creator,omitempty"`            // 创建人（操作人）
-	Created          *string        `url:"created,omitempty"`            // 创建时间（变更时间）	支持时间查询
+	Created          *TimeQuery     `url:"created,omitempty"`            // 创建时间（变更时间）	支持时间查询
 	ChangeSummary    *string        `url:"change_summary,omitempty"`     // 需求变更描述
 	Comment          *string        `url:"comment,omitempty"`            // 评论
 	Changes          *string        `url:"changes,omitempty"`            // 变更详细记录
@@ -251,7 +253,7 @@ type GetTaskChangesCountRequest struct {
 	WorkspaceID   *int          `url:"workspace_id,omitempty"`   // [必须]项目ID
 	TaskID        *int64        `url:"task_id,omitempty"`        // 任务ID
 	Creator       *string       `url:"creator,omitempty"`        // 创建人（操作人）
-	Created       *string       `url:"created,omitempty"`        // 创建时间（变更时间）	支持时间查询
+	Created       *TimeQuery    `url:"created,omitempty"`        // 创建时间（变更时间）	支持时间查询
 	ChangeSummary *string       `url:"change_summary,omitempty"` // 需求变更描述
 	Comment       *string       `url:"comment,omitempty"`        // 评论
 	Changes       *string       `url:"changes,omitempty"`        // 变更详细记录
@@ -278,7 +280,40 @@ func (s *TaskService) GetTaskChangesCount(
 	return response.Count, resp, nil
 }
 
+// -----------------------------------------------------------------------------
 // 获取任务自定义字段配置
+// -----------------------------------------------------------------------------
+
+type GetTaskCustomFieldsSettingsRequest struct {
+	WorkspaceID *int `url:"workspace_id,omitempty"` // [必须]项目ID
+}
+
+// GetTaskCustomFieldsSettings 获取任务自定义字段配置
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/task/get_task_custom_fields_settings.html
+func (s *TaskService) GetTaskCustomFieldsSettings(
+	ctx context.Context, request *GetTaskCustomFieldsSettingsRequest, opts ...RequestOption,
+) ([]*CustomFieldsSetting, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "tasks/custom_fields_settings", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var items []struct {
+		CustomFieldConfig *CustomFieldsSetting `json:"CustomFieldConfig"`
+	}
+	resp, err := s.client.Do(req, &items)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	settings := make([]*CustomFieldsSetting, 0, len(items))
+	for _, item := range items {
+		settings = append(settings, item.CustomFieldConfig)
+	}
+
+	return settings, resp, nil
+}
 
 // -----------------------------------------------------------------------------
 // 获取任务
@@ -290,20 +325,20 @@ type GetTasksRequest struct {
 	Description      *string        `url:"description,omitempty"`      // 任务详细描述
 	WorkspaceID      *int           `url:"workspace_id,omitempty"`     // [必须]项目ID
 	Creator          *string        `url:"creator,omitempty"`          // 创建人	支持多人员查询
-	Created          *string        `url:"created,omitempty"`          // 创建时间	支持时间查询
-	Modified         *string        `url:"modified,omitempty"`         // 最后修改时间	支持时间查询
+	Created          *TimeQuery     `url:"created,omitempty"`          // 创建时间	支持时间查询
+	Modified         *TimeQuery     `url:"modified,omitempty"`         // 最后修改时间	支持时间查询
 	Status           *Enum[string]  `url:"status,omitempty"`           // 状态	支持枚举查询
 	Label            *Enum[string]  `url:"label,omitempty"`            // 标签查询	支持枚举查询
 	Owner            *string        `url:"owner,omitempty"`            // 任务当前处理人	支持模糊匹配
 	CC               *string        `url:"cc,omitempty"`               // 抄送人
-	Begin            *string        `url:"begin,omitempty"`            // 预计开始	支持时间查询
-	Due              *string        `url:"due,omitempty"`              // 预计结束	支持时间查询
+	Begin            *TimeQuery     `url:"begin,omitempty"`            // 预计开始	支持时间查询
+	Due              *TimeQuery     `url:"due,omitempty"`              // 预计结束	支持时间查询
 	StoryID          *Multi[string] `url:"story_id,omitempty"`         // 关联需求的ID	支持多ID查询
 	IterationID      *Enum[string]  `url:"iteration_id,omitempty"`     // 所属迭代的ID	支持枚举查询
 	Priority         *string        `url:"priority,omitempty"`         //nolint:lll // 优先级。为了兼容自定义优先级，请使用 priority_label 字段，详情参考：如何兼容自定义优先级
 	PriorityLabel    *PriorityLabel `url:"priority_label,omitempty"`   // 优先级。推荐使用这个字段
 	Progress         *int           `url:"progress,omitempty"`         // 进度
-	Completed        *string        `url:"completed,omitempty"`        // 完成时间	支持时间查询
+	Completed        *TimeQuery     `url:"completed,omitempty"`        // 完成时间	支持时间查询
 	EffortCompleted  *string        `url:"effort_completed,omitempty"` // 完成工时
 	Exceed           *float64       `url:"exceed,omitempty"`           // 超出工时
 	Remain           *float64       `url:"remain,omitempty"`           // 剩余工时
@@ -397,20 +432,20 @@ type GetTasksCountRequest struct {
 	Description      *string           `url:"description,omitempty"`      // 任务详细描述
 	WorkspaceID      *int              `url:"workspace_id,omitempty"`     // [必须]项目ID
 	Creator          *string           `url:"creator,omitempty"`          // 创建人	支持多人员查询
-	Created          *string           `url:"created,omitempty"`          // 创建时间	支持时间查询
-	Modified         *string           `url:"modified,omitempty"`         // 最后修改时间	支持时间查询
+	Created          *TimeQuery        `url:"created,omitempty"`          // 创建时间	支持时间查询
+	Modified         *TimeQuery        `url:"modified,omitempty"`         // 最后修改时间	支持时间查询
 	Status           *Enum[TaskStatus] `url:"status,omitempty"`           // 状态	支持枚举查询
 	Label            *Enum[string]     `url:"label,omitempty"`            // 标签查询	支持枚举查询
 	Owner            *string           `url:"owner,omitempty"`            // 任务当前处理人	支持模糊匹配
 	CC               *string           `url:"cc,omitempty"`               // 抄送人
-	Begin            *string           `url:"begin,omitempty"`            // 预计开始	支持时间查询
-	Due              *string           `url:"due,omitempty"`              // 预计结束	支持时间查询
+	Begin            *TimeQuery        `url:"begin,omitempty"`            // 预计开始	支持时间查询
+	Due              *TimeQuery        `url:"due,omitempty"`              // 预计结束	支持时间查询
 	StoryID          *Multi[int64]     `url:"story_id,omitempty"`         // 关联需求的ID	支持多ID查询
 	IterationID      *Enum[int64]      `url:"iteration_id,omitempty"`     // 所属迭代的ID	支持枚举查询
 	Priority         *string           `url:"priority,omitempty"`         //nolint:lll // 优先级。为了兼容自定义优先级，请使用 priority_label 字段，详情参考：如何兼容自定义优先级
 	PriorityLabel    *PriorityLabel    `url:"priority_label,omitempty"`   // 优先级。推荐使用这个字段
 	Progress         *int              `url:"progress,omitempty"`         // 进度
-	Completed        *string           `url:"completed,omitempty"`        // 完成时间	支持时间查询
+	Completed        *TimeQuery        `url:"completed,omitempty"`        // 完成时间	支持时间查询
 	EffortCompleted  *string           `url:"effort_completed,omitempty"` // 完成工时
 	Exceed           *float64          `url:"exceed,omitempty"`           // 超出工时
 	Remain           *float64          `url:"remain,omitempty"`           // 剩余工时
@@ -561,7 +596,7 @@ type rawFieldsInfo map[string]struct {
 	PureOptions  []FieldsInfoPureOption  `json:"pure_options,omitempty"`
 }
 
-func convertRawFieldsInfo(raw rawFieldsInfo) []*FieldsInfo {
+func convertRawFieldsInfo(raw rawFieldsInfo) ([]*FieldsInfo, error) {
 	fields := make([]*FieldsInfo, 0, len(raw))
 	for _, item := range raw {
 		options := make([]FieldsInfoOption, 0)
@@ -570,7 +605,7 @@ func convertRawFieldsInfo(raw rawFieldsInfo) []*FieldsInfo {
 		if item.Options != nil {
 			if item.HTMLType == TaskFieldsInfoHTMLTypeCascadeCheckbox || item.HTMLType == TaskFieldsInfoHTMLTypeCascadeRadio {
 				if err := json.Unmarshal(item.Options, &cascade); err != nil {
-					panic(fmt.Errorf("unexpected option array %v %v", item, err))
+					return nil, fmt.Errorf("tapd: unexpected cascade options of field %s: %w", item.Name, err)
 				}
 			} else {
 				decoder := json.NewDecoder(bytes.NewReader(item.Options))
@@ -580,15 +615,14 @@ func convertRawFieldsInfo(raw rawFieldsInfo) []*FieldsInfo {
 				if token == json.Delim('[') {
 					next, _ := decoder.Token()
 					if next != json.Delim(']') {
-						panic(fmt.Errorf("unexpected option array %v", item))
-					} else {
-						isArray = true
+						return nil, fmt.Errorf("tapd: unexpected option array of field %s", item.Name)
 					}
+					isArray = true
 				}
 
 				if !isArray {
 					if token != json.Delim('{') {
-						panic(fmt.Errorf("unexpected option object %v", item))
+						return nil, fmt.Errorf("tapd: unexpected options of field %s", item.Name)
 					}
 
 					for decoder.More() {
@@ -619,7 +653,7 @@ func convertRawFieldsInfo(raw rawFieldsInfo) []*FieldsInfo {
 		})
 	}
 
-	return fields
+	return fields, nil
 }
 
 // GetTaskFieldsInfo 获取任务字段信息
@@ -639,7 +673,10 @@ func (s *TaskService) GetTaskFieldsInfo(
 		return nil, resp, err
 	}
 
-	fields := convertRawFieldsInfo(raw)
+	fields, err := convertRawFieldsInfo(raw)
+	if err != nil {
+		return nil, resp, err
+	}
 	return fields, resp, nil
 }
 
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestTaskService_GetTasks(t *testing.T) {
@@ -43,6 +44,28 @@ func TestTaskService_GetTasksCount(t *testing.T) {
 	assert.Equal(t, 36, count)
 }
 
+func TestTaskService_GetTaskCustomFieldsSettings(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/tasks/custom_fields_settings", r.URL.Path)
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/task/get_task_custom_fields_settings.json"))
+	}))
+
+	settings, _, err := client.TaskService.GetTaskCustomFieldsSettings(ctx, &GetTaskCustomFieldsSettingsRequest{
+		WorkspaceID: Ptr(11112222),
+	})
+	require.NoError(t, err)
+	require.Len(t, settings, 2)
+	assert.Equal(t, "1111112222001000301", settings[0].ID)
+	assert.Equal(t, "task", settings[0].EntryType)
+	assert.Equal(t, "custom_field_one", settings[0].CustomField)
+	assert.Equal(t, "dateinput", settings[0].Type)
+	assert.Equal(t, "截止日期", settings[0].Name)
+	assert.Equal(t, Ptr("华东|华南|华北"), settings[1].Options)
+}
+
 func TestTaskService_GetTaskChanges(t *testing.T) {
 	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(t, http.MethodGet, r.Method)
@@ -143,3 +166,15 @@ func TestTaskService_GetTaskFieldsInfo(t *testing.T) {
 	assert.True(t, flag1)
 	assert.True(t, flag2)
 }
+
+func TestTaskService_GetTaskFieldsInfo_InvalidOptions(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(loadData(t, "internal/testdata/api/task/get_task_fields_info_invalid_options.json"))
+	}))
+
+	fields, _, err := client.TaskService.GetTaskFieldsInfo(ctx, &GetTaskFieldsInfoRequest{
+		WorkspaceID: Ptr(11112222),
+	})
+	assert.ErrorContains(t, err, "custom_field_one")
+	assert.Nil(t, fields)
+}
@@ -0,0 +1,412 @@
+package tapd
+
+import (
+	"context"
+	"net/http"
+)
+
+// TestCaseStatus 测试用例状态
+type TestCaseStatus string
+
+const (
+	TestCaseStatusNormal   TestCaseStatus = "normal"   // 正常
+	TestCaseStatusUpdating TestCaseStatus = "updating" // 待更新
+	TestCaseStatusAbandon  TestCaseStatus = "abandon"  // 已废弃
+)
+
+// TestCase 测试用例
+type TestCase struct {
+	ID           string         `json:"id,omitempty"`           // ID
+	WorkspaceID  string         `json:"workspace_id,omitempty"` // 项目ID
+	CategoryID   string         `json:"category_id,omitempty"`  // 用例目录
+	Name         string         `json:"name,omitempty"`         // 用例名称
+	Steps        string         `json:"steps,omitempty"`        // 用例步骤
+	Precondition string         `json:"precondition,omitempty"` // 前置条件
+	Expectation  string         `json:"expectation,omitempty"`  // 预期结果
+	Type         string         `json:"type,omitempty"`         // 用例类型
+	Status       TestCaseStatus `json:"status,omitempty"`       // 用例状态
+	Priority     string         `json:"priority,omitempty"`     // 用例等级
+	Creator      string         `json:"creator,omitempty"`      // 创建人
+	Created      string         `json:"created,omitempty"`      // 创建时间
+	Modifier     string         `json:"modifier,omitempty"`     // 最后修改人
+	Modified     string         `json:"modified,omitempty"`     // 最后修改时间
+	IsAutomated  string         `json:"is_automated,omitempty"` // 是否自动化
+}
+
+// TestCaseCategory 测试用例目录
+type TestCaseCategory struct {
+	ID          string `json:"id,omitempty"`           // ID
+	WorkspaceID string `json:"workspace_id,omitempty"` // 项目ID
+	Name        string `json:"name,omitempty"`         // 名称
+	Description string `json:"description,omitempty"`  // 描述
+	ParentID    string `json:"parent_id,omitempty"`    // 父目录ID
+	Creator     string `json:"creator,omitempty"`      // 创建人
+	Created     string `json:"created,omitempty"`      // 创建时间
+	Modifier    string `json:"modifier,omitempty"`     // 最后修改人
+	Modified    string `json:"modified,omitempty"`     // 最后修改时间
+}
+
+// TestCaseService 测试用例服务
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/tcase/
+type TestCaseService struct {
+	client *Client
+}
+
+// -----------------------------------------------------------------------------
+// 创建测试用例
+// -----------------------------------------------------------------------------
+
+type CreateTestCaseRequest struct {
+	WorkspaceID  *int            `json:"workspace_id,omitempty"` // [必须]项目ID
+	Name         *string         `json:"name,omitempty"`         // [必须]用例名称
+	CategoryID   *int64          `json:"category_id,omitempty"`  // 用例目录
+	Steps        *string         `json:"steps,omitempty"`        // 用例步骤
+	Precondition *string         `json:"precondition,omitempty"` // 前置条件
+	Expectation  *string         `json:"expectation,omitempty"`  // 预期结果
+	Type         *string         `json:"type,omitempty"`         // 用例类型
+	Status       *TestCaseStatus `json:"status,omitempty"`       // 用例状态
+	Priority     *string         `json:"priority,omitempty"`     // 用例等级
+	Creator      *string         `json:"creator,omitempty"`      // 创建人
+}
+
+// CreateTestCase 创建测试用例
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/tcase/add_tcase.html
+func (s *TestCaseService) CreateTestCase(
+	ctx context.Context, request *CreateTestCaseRequest, opts ...RequestOption,
+) (*TestCase, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "tcases", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var item struct {
+		Tcase *TestCase `json:"Tcase"`
+	}
+	resp, err := s.client.Do(req, &item)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return item.Tcase, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 批量创建测试用例
+// -----------------------------------------------------------------------------
+
+type BatchCreateTestCasesRequest struct {
+	WorkspaceID *int                     `json:"workspace_id,omitempty"` // [必须]项目ID
+	Data        []*CreateTestCaseRequest `json:"data,omitempty"`         // [必须]测试用例列表
+}
+
+// BatchCreateTestCases 批量创建测试用例
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/tcase/batch_add_tcase.html
+func (s *TestCaseService) BatchCreateTestCases(
+	ctx context.Context, request *BatchCreateTestCasesRequest, opts ...RequestOption,
+) ([]*TestCase, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "tcases/batch_save", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var items []struct {
+		Tcase *TestCase `json:"Tcase"`
+	}
+	resp, err := s.client.Do(req, &items)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	testCases := make([]*TestCase, 0, len(items))
+	for _, item := range items {
+		testCases = append(testCases, item.Tcase)
+	}
+
+	return testCases, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 创建测试用例目录
+// -----------------------------------------------------------------------------
+
+type CreateTestCaseCategoryRequest struct {
+	WorkspaceID *int    `json:"workspace_id,omitempty"` // [必须]项目ID
+	Name        *string `json:"name,omitempty"`         // [必须]名称
+	Description *string `json:"description,omitempty"`  // 描述
+	ParentID    *int64  `json:"parent_id,omitempty"`    // 父目录ID
+	Creator     *string `json:"creator,omitempty"`      // 创建人
+}
+
+// CreateTestCaseCategory 创建测试用例目录
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/tcase/add_tcase_category.html
+func (s *TestCaseService) CreateTestCaseCategory(
+	ctx context.Context, request *CreateTestCaseCategoryRequest, opts ...RequestOption,
+) (*TestCaseCategory, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "tcase_categories", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var item struct {
+		TcaseCategory *TestCaseCategory `json:"TcaseCategory"`
+	}
+	resp, err := s.client.Do(req, &item)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return item.TcaseCategory, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 获取测试用例目录
+// -----------------------------------------------------------------------------
+
+type GetTestCaseCategoriesRequest struct {
+	WorkspaceID *int           `url:"workspace_id,omitempty"` // [必须]项目ID
+	ID          *Multi[int64]  `url:"id,omitempty"`           // ID	支持多ID查询
+	Name        *string        `url:"name,omitempty"`         // 名称	支持模糊匹配
+	ParentID    *int64         `url:"parent_id,omitempty"`    // 父目录ID
+	Creator     *string        `url:"creator,omitempty"`      // 创建人
+	Created     *TimeQuery     `url:"created,omitempty"`      // 创建时间	支持时间查询
+	Modified    *TimeQuery     `url:"modified,omitempty"`     // 最后修改时间	支持时间查询
+	Limit       *int           `url:"limit,omitempty"`        // 设置返回数量限制，默认为30
+	Page        *int           `url:"page,omitempty"`         // 返回当前数量限制下第N页的数据，默认为1（第一页）
+	Order       *Order         `url:"order,omitempty"`        // 排序规则，规则：字段名 ASC或者DESC
+	Fields      *Multi[string] `url:"fields,omitempty"`       // 设置获取的字段，多个字段间以','逗号隔开
+}
+
+// GetTestCaseCategories 获取测试用例目录
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/tcase/get_tcase_categories.html
+func (s *TestCaseService) GetTestCaseCategories(
+	ctx context.Context, request *GetTestCaseCategoriesRequest, opts ...RequestOption,
+) ([]*TestCaseCategory, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "tcase_categories", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var items []struct {
+		TcaseCategory *TestCaseCategory `json:"TcaseCategory"`
+	}
+	resp, err := s.client.Do(req, &items)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	categories := make([]*TestCaseCategory, 0, len(items))
+	for _, item := range items {
+		categories = append(categories, item.TcaseCategory)
+	}
+
+	return categories, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 获取测试用例目录数量
+// -----------------------------------------------------------------------------
+
+type GetTestCaseCategoriesCountRequest struct {
+	WorkspaceID *int          `url:"workspace_id,omitempty"` // [必须]项目ID
+	ID          *Multi[int64] `url:"id,omitempty"`           // ID	支持多ID查询
+	Name        *string       `url:"name,omitempty"`         // 名称	支持模糊匹配
+	ParentID    *int64        `url:"parent_id,omitempty"`    // 父目录ID
+	Creator     *string       `url:"creator,omitempty"`      // 创建人
+	Created     *TimeQuery    `url:"created,omitempty"`      // 创建时间	支持时间查询
+	Modified    *TimeQuery    `url:"modified,omitempty"`     // 最后修改时间	支持时间查询
+}
+
+// GetTestCaseCategoriesCount 获取测试用例目录数量
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/tcase/get_tcase_categories_count.html
+func (s *TestCaseService) GetTestCaseCategoriesCount(
+	ctx context.Context, request *GetTestCaseCategoriesCountRequest, opts ...RequestOption,
+) (int, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "tcase_categories/count", request, opts)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var response CountResponse
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return 0, resp, err
+	}
+
+	return response.Count, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 获取测试用例执行结果
+// -----------------------------------------------------------------------------
+
+type GetTestCaseResultsRequest struct {
+	WorkspaceID  *int                    `url:"workspace_id,omitempty"`  // [必须]项目ID
+	TcaseID      *Multi[int64]           `url:"tcase_id,omitempty"`      // 测试用例ID	支持多ID查询
+	TestPlanID   *int64                  `url:"test_plan_id,omitempty"`  // 测试计划ID
+	ResultStatus *Enum[TestResultStatus] `url:"result_status,omitempty"` // 执行结果	支持枚举查询
+	Executor     *string                 `url:"executor,omitempty"`      // 执行人
+	Created      *TimeQuery              `url:"created,omitempty"`       // 执行时间	支持时间查询
+	Limit        *int                    `url:"limit,omitempty"`         // 设置返回数量限制，默认为30
+	Page         *int                    `url:"page,omitempty"`          // 返回当前数量限制下第N页的数据，默认为1（第一页）
+	Order        *Order                  `url:"order,omitempty"`         // 排序规则，规则：字段名 ASC或者DESC
+}
+
+// GetTestCaseResults 获取测试用例执行结果
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/tcase/get_tcase_results.html
+func (s *TestCaseService) GetTestCaseResults(
+	ctx context.Context, request *GetTestCaseResultsRequest, opts ...RequestOption,
+) ([]*TestResult, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "tcases/get_results", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var items []struct {
+		TestResult *TestResult `json:"TestResult"`
+	}
+	resp, err := s.client.Do(req, &items)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	results := make([]*TestResult, 0, len(items))
+	for _, item := range items {
+		results = append(results, item.TestResult)
+	}
+
+	return results, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 获取测试用例
+// -----------------------------------------------------------------------------
+
+type GetTestCasesRequest struct {
+	WorkspaceID *int                  `url:"workspace_id,omitempty"` // [必须]项目ID
+	ID          *Multi[int64]         `url:"id,omitempty"`           // ID	支持多ID查询
+	Name        *string               `url:"name,omitempty"`         // 用例名称	支持模糊匹配
+	CategoryID  *Enum[int64]          `url:"category_id,omitempty"`  // 用例目录	支持枚举查询
+	Type        *string               `url:"type,omitempty"`         // 用例类型
+	Status      *Enum[TestCaseStatus] `url:"status,omitempty"`       // 用例状态	支持枚举查询
+	Priority    *Enum[string]         `url:"priority,omitempty"`     // 用例等级	支持枚举查询
+	Creator     *string               `url:"creator,omitempty"`      // 创建人
+	Modifier    *string               `url:"modifier,omitempty"`     // 最后修改人
+	Created     *TimeQuery            `url:"created,omitempty"`      // 创建时间	支持时间查询
+	Modified    *TimeQuery            `url:"modified,omitempty"`     // 最后修改时间	支持时间查询
+	Limit       *int                  `url:"limit,omitempty"`        // 设置返回数量限制，默认为30
+	Page        *int                  `url:"page,omitempty"`         // 返回当前数量限制下第N页的数据，默认为1（第一页）
+	Order       *Order                `url:"order,omitempty"`        // 排序规则，规则：字段名 ASC或者DESC
+	Fields      *Multi[string]        `url:"fields,omitempty"`       // 设置获取的字段，多个字段间以','逗号隔开
+}
+
+// GetTestCases 获取测试用例
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/tcase/get_tcases.html
+func (s *TestCaseService) GetTestCases(
+	ctx context.Context, request *GetTestCasesRequest, opts ...RequestOption,
+) ([]*TestCase, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "tcases", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var items []struct {
+		Tcase *TestCase `json:"Tcase"`
+	}
+	resp, err := s.client.Do(req, &items)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	testCases := make([]*TestCase, 0, len(items))
+	for _, item := range items {
+		testCases = append(testCases, item.Tcase)
+	}
+
+	return testCases, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 获取测试用例数量
+// -----------------------------------------------------------------------------
+
+type GetTestCasesCountRequest struct {
+	WorkspaceID *int                  `url:"workspace_id,omitempty"` // [必须]项目ID
+	ID          *Multi[int64]         `url:"id,omitempty"`           // ID	支持多ID查询
+	Name        *string               `url:"name,omitempty"`         // 用例名称	支持模糊匹配
+	CategoryID  *Enum[int64]          `url:"category_id,omitempty"`  // 用例目录	支持枚举查询
+	Type        *string               `url:"type,omitempty"`         // 用例类型
+	Status      *Enum[TestCaseStatus] `url:"status,omitempty"`       // 用例状态	支持枚举查询
+	Priority    *Enum[string]         `url:"priority,omitempty"`     // 用例等级	支持枚举查询
+	Creator     *string               `url:"creator,omitempty"`      // 创建人
+	Modifier    *string               `url:"modifier,omitempty"`     // 最后修改人
+	Created     *TimeQuery            `url:"created,omitempty"`      // 创建时间	支持时间查询
+	Modified    *TimeQuery            `url:"modified,omitempty"`     // 最后修改时间	支持时间查询
+}
+
+// GetTestCasesCount 获取测试用例数量
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/tcase/get_tcases_count.html
+func (s *TestCaseService) GetTestCasesCount(
+	ctx context.Context, request *GetTestCasesCountRequest, opts ...RequestOption,
+) (int, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "tcases/count", request, opts)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var response CountResponse
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return 0, resp, err
+	}
+
+	return response.Count, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 更新测试用例
+// -----------------------------------------------------------------------------
+
+type UpdateTestCaseRequest struct {
+	WorkspaceID  *int            `json:"workspace_id,omitempty"` // [必须]项目ID
+	ID           *int64          `json:"id,omitempty"`           // [必须]ID
+	Name         *string         `json:"name,omitempty"`         // 用例名称
+	CategoryID   *int64          `json:"category_id,omitempty"`  // 用例目录
+	Steps        *string         `json:"steps,omitempty"`        // 用例步骤
+	Precondition *string         `json:"precondition,omitempty"` // 前置条件
+	Expectation  *string         `json:"expectation,omitempty"`  // 预期结果
+	Type         *string         `json:"type,omitempty"`         // 用例类型
+	Status       *TestCaseStatus `json:"status,omitempty"`       // 用例状态
+	Priority     *string         `json:"priority,omitempty"`     // 用例等级
+	Modifier     *string         `json:"modifier,omitempty"`     // 最后修改人
+}
+
+// UpdateTestCase 更新测试用例
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/tcase/update_tcase.html
+func (s *TestCaseService) UpdateTestCase(
+	ctx context.Context, request *UpdateTestCaseRequest, opts ...RequestOption,
+) (*TestCase, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "tcases", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var item struct {
+		Tcase *TestCase `json:"Tcase"`
+	}
+	resp, err := s.client.Do(req, &item)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return item.Tcase, resp, nil
+}
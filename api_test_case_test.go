@@ -0,0 +1,237 @@
+package tapd
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTestCaseService_CreateTestCase(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/tcases", r.URL.Path)
+
+		var req struct {
+			WorkspaceID int    `json:"workspace_id"`
+			Name        string `json:"name"`
+			CategoryID  int64  `json:"category_id"`
+			Priority    string `json:"priority"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, 11112222, req.WorkspaceID)
+		assert.Equal(t, "登录成功", req.Name)
+		assert.Equal(t, int64(1111112222001000011), req.CategoryID)
+		assert.Equal(t, "高", req.Priority)
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/test_case/create_test_case.json"))
+	}))
+
+	testCase, _, err := client.TestCaseService.CreateTestCase(ctx, &CreateTestCaseRequest{
+		WorkspaceID: Ptr(11112222),
+		Name:        Ptr("登录成功"),
+		CategoryID:  Ptr[int64](1111112222001000011),
+		Priority:    Ptr("高"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "1111112222001000101", testCase.ID)
+	assert.Equal(t, "11112222", testCase.WorkspaceID)
+	assert.Equal(t, "1111112222001000011", testCase.CategoryID)
+	assert.Equal(t, "登录成功", testCase.Name)
+	assert.Equal(t, "1. 输入账号密码\n2. 点击登录", testCase.Steps)
+	assert.Equal(t, "账号已注册", testCase.Precondition)
+	assert.Equal(t, "进入首页", testCase.Expectation)
+	assert.Equal(t, TestCaseStatusNormal, testCase.Status)
+	assert.Equal(t, "1", testCase.IsAutomated)
+}
+
+func TestTestCaseService_BatchCreateTestCases(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/tcases/batch_save", r.URL.Path)
+
+		var req struct {
+			WorkspaceID int `json:"workspace_id"`
+			Data        []struct {
+				Name string `json:"name"`
+			} `json:"data"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, 11112222, req.WorkspaceID)
+		require.Len(t, req.Data, 2)
+		assert.Equal(t, "登录成功", req.Data[0].Name)
+		assert.Equal(t, "密码错误", req.Data[1].Name)
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/test_case/batch_create_test_cases.json"))
+	}))
+
+	testCases, _, err := client.TestCaseService.BatchCreateTestCases(ctx, &BatchCreateTestCasesRequest{
+		WorkspaceID: Ptr(11112222),
+		Data: []*CreateTestCaseRequest{
+			{Name: Ptr("登录成功")},
+			{Name: Ptr("密码错误")},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, testCases, 2)
+	assert.Equal(t, "1111112222001000101", testCases[0].ID)
+	assert.Equal(t, "1111112222001000102", testCases[1].ID)
+	assert.Equal(t, "密码错误", testCases[1].Name)
+}
+
+func TestTestCaseService_CreateTestCaseCategory(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/tcase_categories", r.URL.Path)
+
+		var req struct {
+			WorkspaceID int    `json:"workspace_id"`
+			Name        string `json:"name"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, 11112222, req.WorkspaceID)
+		assert.Equal(t, "登录模块", req.Name)
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/test_case/create_test_case_category.json"))
+	}))
+
+	category, _, err := client.TestCaseService.CreateTestCaseCategory(ctx, &CreateTestCaseCategoryRequest{
+		WorkspaceID: Ptr(11112222),
+		Name:        Ptr("登录模块"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "1111112222001000011", category.ID)
+	assert.Equal(t, "登录模块", category.Name)
+	assert.Equal(t, "登录相关用例", category.Description)
+	assert.Equal(t, "0", category.ParentID)
+}
+
+func TestTestCaseService_GetTestCaseCategories(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/tcase_categories", r.URL.Path)
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/test_case/get_test_case_categories.json"))
+	}))
+
+	categories, _, err := client.TestCaseService.GetTestCaseCategories(ctx, &GetTestCaseCategoriesRequest{
+		WorkspaceID: Ptr(11112222),
+	})
+	require.NoError(t, err)
+	require.Len(t, categories, 2)
+	assert.Equal(t, "1111112222001000012", categories[1].ID)
+	assert.Equal(t, "第三方登录", categories[1].Name)
+	assert.Equal(t, "1111112222001000011", categories[1].ParentID)
+}
+
+func TestTestCaseService_GetTestCaseCategoriesCount(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/tcase_categories/count", r.URL.Path)
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/test_case/get_test_case_categories_count.json"))
+	}))
+
+	count, _, err := client.TestCaseService.GetTestCaseCategoriesCount(ctx, &GetTestCaseCategoriesCountRequest{
+		WorkspaceID: Ptr(11112222),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+}
+
+func TestTestCaseService_GetTestCaseResults(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/tcases/get_results", r.URL.Path)
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+		assert.Equal(t, "1111112222001000101,1111112222001000102", r.URL.Query().Get("tcase_id"))
+		assert.Equal(t, "pass|no_pass", r.URL.Query().Get("result_status"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/test_case/get_test_case_results.json"))
+	}))
+
+	results, _, err := client.TestCaseService.GetTestCaseResults(ctx, &GetTestCaseResultsRequest{
+		WorkspaceID:  Ptr(11112222),
+		TcaseID:      NewMulti[int64](1111112222001000101, 1111112222001000102),
+		ResultStatus: NewEnum(TestResultStatusPass, TestResultStatusNoPass),
+	})
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	assert.Equal(t, "1111112222001000501", results[0].ID)
+	assert.Equal(t, "1111112222001000031", results[0].TestPlanID)
+	assert.Equal(t, TestResultStatusPass, results[0].ResultStatus)
+	assert.Equal(t, "ci-bot", results[0].Executor)
+	assert.Equal(t, TestResultStatusNoPass, results[1].ResultStatus)
+	assert.Equal(t, "1111112222001035927", results[1].BugIDs)
+}
+
+func TestTestCaseService_GetTestCases(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/tcases", r.URL.Path)
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+		assert.Equal(t, "normal|updating", r.URL.Query().Get("status"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/test_case/get_test_cases.json"))
+	}))
+
+	testCases, _, err := client.TestCaseService.GetTestCases(ctx, &GetTestCasesRequest{
+		WorkspaceID: Ptr(11112222),
+		Status:      NewEnum(TestCaseStatusNormal, TestCaseStatusUpdating),
+	})
+	require.NoError(t, err)
+	require.Len(t, testCases, 2)
+	assert.Equal(t, "1111112222001000101", testCases[0].ID)
+	assert.Equal(t, "登录成功", testCases[0].Name)
+	assert.Equal(t, "密码错误", testCases[1].Name)
+	assert.Equal(t, "中", testCases[1].Priority)
+}
+
+func TestTestCaseService_GetTestCasesCount(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/tcases/count", r.URL.Path)
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/test_case/get_test_cases_count.json"))
+	}))
+
+	count, _, err := client.TestCaseService.GetTestCasesCount(ctx, &GetTestCasesCountRequest{
+		WorkspaceID: Ptr(11112222),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 128, count)
+}
+
+func TestTestCaseService_UpdateTestCase(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/tcases", r.URL.Path)
+
+		var req struct {
+			WorkspaceID int            `json:"workspace_id"`
+			ID          int64          `json:"id"`
+			Status      TestCaseStatus `json:"status"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, 11112222, req.WorkspaceID)
+		assert.Equal(t, int64(1111112222001000101), req.ID)
+		assert.Equal(t, TestCaseStatusUpdating, req.Status)
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/test_case/update_test_case.json"))
+	}))
+
+	testCase, _, err := client.TestCaseService.UpdateTestCase(ctx, &UpdateTestCaseRequest{
+		WorkspaceID: Ptr(11112222),
+		ID:          Ptr[int64](1111112222001000101),
+		Status:      Ptr(TestCaseStatusUpdating),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "1111112222001000101", testCase.ID)
+	assert.Equal(t, TestCaseStatusUpdating, testCase.Status)
+	assert.Equal(t, "李四", testCase.Modifier)
+}
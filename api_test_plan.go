@@ -0,0 +1,488 @@
+package tapd
+
+import (
+	"context"
+	"net/http"
+)
+
+// TestPlanStatus 测试计划状态
+type TestPlanStatus string
+
+const (
+	TestPlanStatusOpen   TestPlanStatus = "open"   // 开启
+	TestPlanStatusDone   TestPlanStatus = "done"   // 已完成
+	TestPlanStatusClosed TestPlanStatus = "closed" // 已关闭
+)
+
+// TestResultStatus 测试用例执行结果
+type TestResultStatus string
+
+const (
+	TestResultStatusPass       TestResultStatus = "pass"       // 通过
+	TestResultStatusNoPass     TestResultStatus = "no_pass"    // 未通过
+	TestResultStatusBlock      TestResultStatus = "block"      // 阻塞
+	TestResultStatusUnexecuted TestResultStatus = "unexecuted" // 未执行
+)
+
+// TestPlan 测试计划
+type TestPlan struct {
+	ID          string         `json:"id,omitempty"`           // ID
+	WorkspaceID string         `json:"workspace_id,omitempty"` // 项目ID
+	Name        string         `json:"name,omitempty"`         // 测试计划名称
+	Description string         `json:"description,omitempty"`  // 测试计划描述
+	Version     string         `json:"version,omitempty"`      // 版本
+	Owner       string         `json:"owner,omitempty"`        // 测试负责人
+	Status      TestPlanStatus `json:"status,omitempty"`       // 状态
+	Type        string         `json:"type,omitempty"`         // 测试类型
+	StartDate   string         `json:"start_date,omitempty"`   // 开始时间
+	EndDate     string         `json:"end_date,omitempty"`     // 结束时间
+	Creator     string         `json:"creator,omitempty"`      // 创建人
+	Created     string         `json:"created,omitempty"`      // 创建时间
+	Modifier    string         `json:"modifier,omitempty"`     // 最后修改人
+	Modified    string         `json:"modified,omitempty"`     // 最后修改时间
+}
+
+// TestResult 测试用例执行结果
+type TestResult struct {
+	ID           string           `json:"id,omitempty"`            // ID
+	WorkspaceID  string           `json:"workspace_id,omitempty"`  // 项目ID
+	TestPlanID   string           `json:"test_plan_id,omitempty"`  // 测试计划ID
+	TcaseID      string           `json:"tcase_id,omitempty"`      // 测试用例ID
+	ResultStatus TestResultStatus `json:"result_status,omitempty"` // 执行结果
+	Executor     string           `json:"executor,omitempty"`      // 执行人
+	Remark       string           `json:"remark,omitempty"`        // 备注
+	BugIDs       string           `json:"bug_ids,omitempty"`       // 关联缺陷ID，多个以英文逗号分隔
+	Created      string           `json:"created,omitempty"`       // 执行时间
+}
+
+// TestPlanStoryRelation 测试计划与需求关联关系
+type TestPlanStoryRelation struct {
+	ID          string `json:"id,omitempty"`           // ID
+	WorkspaceID string `json:"workspace_id,omitempty"` // 项目ID
+	TestPlanID  string `json:"test_plan_id,omitempty"` // 测试计划ID
+	StoryID     string `json:"story_id,omitempty"`     // 需求ID
+	Creator     string `json:"creator,omitempty"`      // 创建人
+	Created     string `json:"created,omitempty"`      // 创建时间
+}
+
+// TestPlanTestCaseRelation 测试计划与测试用例关联关系
+type TestPlanTestCaseRelation struct {
+	ID           string           `json:"id,omitempty"`            // ID
+	WorkspaceID  string           `json:"workspace_id,omitempty"`  // 项目ID
+	TestPlanID   string           `json:"test_plan_id,omitempty"`  // 测试计划ID
+	TcaseID      string           `json:"tcase_id,omitempty"`      // 测试用例ID
+	Executor     string           `json:"executor,omitempty"`      // 执行人
+	ResultStatus TestResultStatus `json:"result_status,omitempty"` // 最近一次执行结果
+	Creator      string           `json:"creator,omitempty"`       // 创建人
+	Created      string           `json:"created,omitempty"`       // 创建时间
+	Modified     string           `json:"modified,omitempty"`      // 最后修改时间
+}
+
+// TestPlanProgress 测试计划执行进度
+type TestPlanProgress struct {
+	Total      int `json:"total"`      // 用例总数
+	Executed   int `json:"executed"`   // 已执行
+	Unexecuted int `json:"unexecuted"` // 未执行
+	Pass       int `json:"pass"`       // 通过
+	NoPass     int `json:"no_pass"`    // 未通过
+	Block      int `json:"block"`      // 阻塞
+}
+
+// TestPlanService 测试计划服务
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/test_plan/
+type TestPlanService struct {
+	client *Client
+}
+
+// -----------------------------------------------------------------------------
+// 创建测试计划
+// -----------------------------------------------------------------------------
+
+type CreateTestPlanRequest struct {
+	WorkspaceID *int            `json:"workspace_id,omitempty"` // [必须]项目ID
+	Name        *string         `json:"name,omitempty"`         // [必须]测试计划名称
+	Description *string         `json:"description,omitempty"`  // 测试计划描述
+	Version     *string         `json:"version,omitempty"`      // 版本
+	Owner       *string         `json:"owner,omitempty"`        // 测试负责人
+	Status      *TestPlanStatus `json:"status,omitempty"`       // 状态
+	Type        *string         `json:"type,omitempty"`         // 测试类型
+	StartDate   *string         `json:"start_date,omitempty"`   // 开始时间
+	EndDate     *string         `json:"end_date,omitempty"`     // 结束时间
+	Creator     *string         `json:"creator,omitempty"`      // 创建人
+}
+
+// CreateTestPlan 创建测试计划
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/test_plan/add_test_plan.html
+func (s *TestPlanService) CreateTestPlan(
+	ctx context.Context, request *CreateTestPlanRequest, opts ...RequestOption,
+) (*TestPlan, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "test_plans", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var item struct {
+		TestPlan *TestPlan `json:"TestPlan"`
+	}
+	resp, err := s.client.Do(req, &item)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return item.TestPlan, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 创建测试计划和需求关联关系
+// -----------------------------------------------------------------------------
+
+type CreateTestPlanStoryRelationsRequest struct {
+	WorkspaceID *int          `json:"workspace_id,omitempty"` // [必须]项目ID
+	TestPlanID  *int64        `json:"test_plan_id,omitempty"` // [必须]测试计划ID
+	StoryIDs    *Multi[int64] `json:"story_ids,omitempty"`    // [必须]需求ID
+	Creator     *string       `json:"creator,omitempty"`      // 创建人
+}
+
+// CreateTestPlanStoryRelations 创建测试计划和需求关联关系
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/test_plan/create_test_plan_story_relation.html
+func (s *TestPlanService) CreateTestPlanStoryRelations(
+	ctx context.Context, request *CreateTestPlanStoryRelationsRequest, opts ...RequestOption,
+) ([]*TestPlanStoryRelation, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "test_plans/create_story_relation", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var items []struct {
+		TestPlanStoryRelation *TestPlanStoryRelation `json:"TestPlanStoryRelation"`
+	}
+	resp, err := s.client.Do(req, &items)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	relations := make([]*TestPlanStoryRelation, 0, len(items))
+	for _, item := range items {
+		relations = append(relations, item.TestPlanStoryRelation)
+	}
+
+	return relations, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 创建测试计划和测试用例关联关系
+// -----------------------------------------------------------------------------
+
+type CreateTestPlanTestCaseRelationsRequest struct {
+	WorkspaceID *int          `json:"workspace_id,omitempty"` // [必须]项目ID
+	TestPlanID  *int64        `json:"test_plan_id,omitempty"` // [必须]测试计划ID
+	TcaseIDs    *Multi[int64] `json:"tcase_ids,omitempty"`    // [必须]测试用例ID
+	Executor    *string       `json:"executor,omitempty"`     // 执行人
+	Creator     *string       `json:"creator,omitempty"`      // 创建人
+}
+
+// CreateTestPlanTestCaseRelations 创建测试计划和测试用例关联关系
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/test_plan/create_test_plan_tcase_relation.html
+func (s *TestPlanService) CreateTestPlanTestCaseRelations(
+	ctx context.Context, request *CreateTestPlanTestCaseRelationsRequest, opts ...RequestOption,
+) ([]*TestPlanTestCaseRelation, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "test_plans/create_tcase_relation", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var items []struct {
+		TestPlanTcaseRelation *TestPlanTestCaseRelation `json:"TestPlanTcaseRelation"`
+	}
+	resp, err := s.client.Do(req, &items)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	relations := make([]*TestPlanTestCaseRelation, 0, len(items))
+	for _, item := range items {
+		relations = append(relations, item.TestPlanTcaseRelation)
+	}
+
+	return relations, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 执行测试用例
+// -----------------------------------------------------------------------------
+
+type ExecuteTestCaseRequest struct {
+	WorkspaceID  *int              `json:"workspace_id,omitempty"`  // [必须]项目ID
+	TestPlanID   *int64            `json:"test_plan_id,omitempty"`  // [必须]测试计划ID
+	TcaseID      *int64            `json:"tcase_id,omitempty"`      // [必须]测试用例ID
+	ResultStatus *TestResultStatus `json:"result_status,omitempty"` // [必须]执行结果
+	Executor     *string           `json:"executor,omitempty"`      // 执行人
+	Remark       *string           `json:"remark,omitempty"`        // 备注
+	BugIDs       *Multi[int64]     `json:"bug_ids,omitempty"`       // 关联缺陷ID
+}
+
+// ExecuteTestCase 执行测试用例
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/test_plan/execute_tcase.html
+func (s *TestPlanService) ExecuteTestCase(
+	ctx context.Context, request *ExecuteTestCaseRequest, opts ...RequestOption,
+) (*TestResult, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "test_plans/execute_tcase", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var item struct {
+		TestResult *TestResult `json:"TestResult"`
+	}
+	resp, err := s.client.Do(req, &item)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return item.TestResult, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 获取测试计划测试结果
+// -----------------------------------------------------------------------------
+
+type GetTestPlanResultsRequest struct {
+	WorkspaceID  *int                    `url:"workspace_id,omitempty"`  // [必须]项目ID
+	TestPlanID   *int64                  `url:"test_plan_id,omitempty"`  // [必须]测试计划ID
+	TcaseID      *Multi[int64]           `url:"tcase_id,omitempty"`      // 测试用例ID	支持多ID查询
+	ResultStatus *Enum[TestResultStatus] `url:"result_status,omitempty"` // 执行结果	支持枚举查询
+	Executor     *string                 `url:"executor,omitempty"`      // 执行人
+	Limit        *int                    `url:"limit,omitempty"`         // 设置返回数量限制，默认为30
+	Page         *int                    `url:"page,omitempty"`          // 返回当前数量限制下第N页的数据，默认为1（第一页）
+}
+
+// GetTestPlanResults 获取测试计划测试结果
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/test_plan/get_test_plan_results.html
+func (s *TestPlanService) GetTestPlanResults(
+	ctx context.Context, request *GetTestPlanResultsRequest, opts ...RequestOption,
+) ([]*TestResult, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "test_plans/get_test_results", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var items []struct {
+		TestResult *TestResult `json:"TestResult"`
+	}
+	resp, err := s.client.Do(req, &items)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	results := make([]*TestResult, 0, len(items))
+	for _, item := range items {
+		results = append(results, item.TestResult)
+	}
+
+	return results, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 获取测试计划执行进度
+// -----------------------------------------------------------------------------
+
+type GetTestPlanProgressRequest struct {
+	WorkspaceID *int   `url:"workspace_id,omitempty"` // [必须]项目ID
+	TestPlanID  *int64 `url:"test_plan_id,omitempty"` // [必须]测试计划ID
+}
+
+// GetTestPlanProgress 获取测试计划执行进度
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/test_plan/get_test_plan_progress.html
+func (s *TestPlanService) GetTestPlanProgress(
+	ctx context.Context, request *GetTestPlanProgressRequest, opts ...RequestOption,
+) (*TestPlanProgress, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "test_plans/progress", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	progress := new(TestPlanProgress)
+	resp, err := s.client.Do(req, progress)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return progress, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 获取测试计划与测试用例关联关系
+// -----------------------------------------------------------------------------
+
+type GetTestPlanTestCaseRelationsRequest struct {
+	WorkspaceID  *int                    `url:"workspace_id,omitempty"`  // [必须]项目ID
+	TestPlanID   *int64                  `url:"test_plan_id,omitempty"`  // [必须]测试计划ID
+	TcaseID      *Multi[int64]           `url:"tcase_id,omitempty"`      // 测试用例ID	支持多ID查询
+	Executor     *string                 `url:"executor,omitempty"`      // 执行人
+	ResultStatus *Enum[TestResultStatus] `url:"result_status,omitempty"` // 最近一次执行结果	支持枚举查询
+	Limit        *int                    `url:"limit,omitempty"`         // 设置返回数量限制，默认为30
+	Page         *int                    `url:"page,omitempty"`          // 返回当前数量限制下第N页的数据，默认为1（第一页）
+}
+
+// GetTestPlanTestCaseRelations 获取测试计划与测试用例关联关系
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/test_plan/get_test_plan_tcase_relations.html
+func (s *TestPlanService) GetTestPlanTestCaseRelations(
+	ctx context.Context, request *GetTestPlanTestCaseRelationsRequest, opts ...RequestOption,
+) ([]*TestPlanTestCaseRelation, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "test_plans/tcase_relations", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var items []struct {
+		TestPlanTcaseRelation *TestPlanTestCaseRelation `json:"TestPlanTcaseRelation"`
+	}
+	resp, err := s.client.Do(req, &items)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	relations := make([]*TestPlanTestCaseRelation, 0, len(items))
+	for _, item := range items {
+		relations = append(relations, item.TestPlanTcaseRelation)
+	}
+
+	return relations, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 获取测试计划
+// -----------------------------------------------------------------------------
+
+type GetTestPlansRequest struct {
+	WorkspaceID *int                  `url:"workspace_id,omitempty"` // [必须]项目ID
+	ID          *Multi[int64]         `url:"id,omitempty"`           // ID	支持多ID查询
+	Name        *string               `url:"name,omitempty"`         // 测试计划名称	支持模糊匹配
+	Version     *string               `url:"version,omitempty"`      // 版本
+	Owner       *string               `url:"owner,omitempty"`        // 测试负责人
+	Status      *Enum[TestPlanStatus] `url:"status,omitempty"`       // 状态	支持枚举查询
+	Type        *string               `url:"type,omitempty"`         // 测试类型
+	StartDate   *TimeQuery            `url:"start_date,omitempty"`   // 开始时间	支持时间查询
+	EndDate     *TimeQuery            `url:"end_date,omitempty"`     // 结束时间	支持时间查询
+	Creator     *string               `url:"creator,omitempty"`      // 创建人
+	Created     *TimeQuery            `url:"created,omitempty"`      // 创建时间	支持时间查询
+	Modified    *TimeQuery            `url:"modified,omitempty"`     // 最后修改时间	支持时间查询
+	Limit       *int                  `url:"limit,omitempty"`        // 设置返回数量限制，默认为30
+	Page        *int                  `url:"page,omitempty"`         // 返回当前数量限制下第N页的数据，默认为1（第一页）
+	Order       *Order                `url:"order,omitempty"`        // 排序规则，规则：字段名 ASC或者DESC
+	Fields      *Multi[string]        `url:"fields,omitempty"`       // 设置获取的字段，多个字段间以','逗号隔开
+}
+
+// GetTestPlans 获取测试计划
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/test_plan/get_test_plans.html
+func (s *TestPlanService) GetTestPlans(
+	ctx context.Context, request *GetTestPlansRequest, opts ...RequestOption,
+) ([]*TestPlan, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "test_plans", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var items []struct {
+		TestPlan *TestPlan `json:"TestPlan"`
+	}
+	resp, err := s.client.Do(req, &items)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	plans := make([]*TestPlan, 0, len(items))
+	for _, item := range items {
+		plans = append(plans, item.TestPlan)
+	}
+
+	return plans, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 获取测试计划数量
+// -----------------------------------------------------------------------------
+
+type GetTestPlansCountRequest struct {
+	WorkspaceID *int                  `url:"workspace_id,omitempty"` // [必须]项目ID
+	ID          *Multi[int64]         `url:"id,omitempty"`           // ID	支持多ID查询
+	Name        *string               `url:"name,omitempty"`         // 测试计划名称	支持模糊匹配
+	Version     *string               `url:"version,omitempty"`      // 版本
+	Owner       *string               `url:"owner,omitempty"`        // 测试负责人
+	Status      *Enum[TestPlanStatus] `url:"status,omitempty"`       // 状态	支持枚举查询
+	Type        *string               `url:"type,omitempty"`         // 测试类型
+	StartDate   *TimeQuery            `url:"start_date,omitempty"`   // 开始时间	支持时间查询
+	EndDate     *TimeQuery            `url:"end_date,omitempty"`     // 结束时间	支持时间查询
+	Creator     *string               `url:"creator,omitempty"`      // 创建人
+	Created     *TimeQuery            `url:"created,omitempty"`      // 创建时间	支持时间查询
+	Modified    *TimeQuery            `url:"modified,omitempty"`     // 最后修改时间	支持时间查询
+}
+
+// GetTestPlansCount 获取测试计划数量
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/test_plan/get_test_plans_count.html
+func (s *TestPlanService) GetTestPlansCount(
+	ctx context.Context, request *GetTestPlansCountRequest, opts ...RequestOption,
+) (int, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "test_plans/count", request, opts)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var response CountResponse
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return 0, resp, err
+	}
+
+	return response.Count, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 编辑测试计划
+// -----------------------------------------------------------------------------
+
+type UpdateTestPlanRequest struct {
+	WorkspaceID *int            `json:"workspace_id,omitempty"` // [必须]项目ID
+	ID          *int64          `json:"id,omitempty"`           // [必须]ID
+	Name        *string         `json:"name,omitempty"`         // 测试计划名称
+	Description *string         `json:"description,omitempty"`  // 测试计划描述
+	Version     *string         `json:"version,omitempty"`      // 版本
+	Owner       *string         `json:"owner,omitempty"`        // 测试负责人
+	Status      *TestPlanStatus `json:"status,omitempty"`       // 状态
+	Type        *string         `json:"type,omitempty"`         // 测试类型
+	StartDate   *string         `json:"start_date,omitempty"`   // 开始时间
+	EndDate     *string         `json:"end_date,omitempty"`     // 结束时间
+	Modifier    *string         `json:"modifier,omitempty"`     // 最后修改人
+}
+
+// UpdateTestPlan 编辑测试计划
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/test_plan/update_test_plan.html
+func (s *TestPlanService) UpdateTestPlan(
+	ctx context.Context, request *UpdateTestPlanRequest, opts ...RequestOption,
+) (*TestPlan, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "test_plans", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var item struct {
+		TestPlan *TestPlan `json:"TestPlan"`
+	}
+	resp, err := s.client.Do(req, &item)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return item.TestPlan, resp, nil
+}
@@ -0,0 +1,282 @@
+package tapd
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTestPlanService_CreateTestPlan(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/test_plans", r.URL.Path)
+
+		var req struct {
+			WorkspaceID int    `json:"workspace_id"`
+			Name        string `json:"name"`
+			Owner       string `json:"owner"`
+			StartDate   string `json:"start_date"`
+			EndDate     string `json:"end_date"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, 11112222, req.WorkspaceID)
+		assert.Equal(t, "v1.2 回归测试", req.Name)
+		assert.Equal(t, "张三", req.Owner)
+		assert.Equal(t, "2025-01-06", req.StartDate)
+		assert.Equal(t, "2025-01-10", req.EndDate)
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/test_plan/create_test_plan.json"))
+	}))
+
+	plan, _, err := client.TestPlanService.CreateTestPlan(ctx, &CreateTestPlanRequest{
+		WorkspaceID: Ptr(11112222),
+		Name:        Ptr("v1.2 回归测试"),
+		Owner:       Ptr("张三"),
+		StartDate:   Ptr("2025-01-06"),
+		EndDate:     Ptr("2025-01-10"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "1111112222001000031", plan.ID)
+	assert.Equal(t, "11112222", plan.WorkspaceID)
+	assert.Equal(t, "v1.2 回归测试", plan.Name)
+	assert.Equal(t, "v1.2", plan.Version)
+	assert.Equal(t, TestPlanStatusOpen, plan.Status)
+	assert.Equal(t, "2025-01-06", plan.StartDate)
+	assert.Equal(t, "2025-01-10", plan.EndDate)
+}
+
+func TestTestPlanService_CreateTestPlanStoryRelations(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/test_plans/create_story_relation", r.URL.Path)
+
+		var req struct {
+			WorkspaceID int    `json:"workspace_id"`
+			TestPlanID  int64  `json:"test_plan_id"`
+			StoryIDs    string `json:"story_ids"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, 11112222, req.WorkspaceID)
+		assert.Equal(t, int64(1111112222001000031), req.TestPlanID)
+		assert.Equal(t, "1111112222001063941,1111112222001063942", req.StoryIDs)
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/test_plan/create_test_plan_story_relations.json"))
+	}))
+
+	relations, _, err := client.TestPlanService.CreateTestPlanStoryRelations(ctx, &CreateTestPlanStoryRelationsRequest{
+		WorkspaceID: Ptr(11112222),
+		TestPlanID:  Ptr[int64](1111112222001000031),
+		StoryIDs:    NewMulti[int64](1111112222001063941, 1111112222001063942),
+	})
+	require.NoError(t, err)
+	require.Len(t, relations, 2)
+	assert.Equal(t, "1111112222001000031", relations[0].TestPlanID)
+	assert.Equal(t, "1111112222001063941", relations[0].StoryID)
+	assert.Equal(t, "1111112222001063942", relations[1].StoryID)
+}
+
+func TestTestPlanService_CreateTestPlanTestCaseRelations(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/test_plans/create_tcase_relation", r.URL.Path)
+
+		var req struct {
+			WorkspaceID int    `json:"workspace_id"`
+			TestPlanID  int64  `json:"test_plan_id"`
+			TcaseIDs    string `json:"tcase_ids"`
+			Executor    string `json:"executor"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, 11112222, req.WorkspaceID)
+		assert.Equal(t, int64(1111112222001000031), req.TestPlanID)
+		assert.Equal(t, "1111112222001000101,1111112222001000102", req.TcaseIDs)
+		assert.Equal(t, "ci-bot", req.Executor)
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/test_plan/create_test_plan_test_case_relations.json"))
+	}))
+
+	relations, _, err := client.TestPlanService.CreateTestPlanTestCaseRelations(ctx, &CreateTestPlanTestCaseRelationsRequest{
+		WorkspaceID: Ptr(11112222),
+		TestPlanID:  Ptr[int64](1111112222001000031),
+		TcaseIDs:    NewMulti[int64](1111112222001000101, 1111112222001000102),
+		Executor:    Ptr("ci-bot"),
+	})
+	require.NoError(t, err)
+	require.Len(t, relations, 2)
+	assert.Equal(t, "1111112222001000101", relations[0].TcaseID)
+	assert.Equal(t, "ci-bot", relations[0].Executor)
+	assert.Equal(t, TestResultStatusUnexecuted, relations[0].ResultStatus)
+}
+
+func TestTestPlanService_ExecuteTestCase(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/test_plans/execute_tcase", r.URL.Path)
+
+		var req struct {
+			WorkspaceID  int              `json:"workspace_id"`
+			TestPlanID   int64            `json:"test_plan_id"`
+			TcaseID      int64            `json:"tcase_id"`
+			ResultStatus TestResultStatus `json:"result_status"`
+			Executor     string           `json:"executor"`
+			Remark       string           `json:"remark"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, 11112222, req.WorkspaceID)
+		assert.Equal(t, int64(1111112222001000031), req.TestPlanID)
+		assert.Equal(t, int64(1111112222001000101), req.TcaseID)
+		assert.Equal(t, TestResultStatusPass, req.ResultStatus)
+		assert.Equal(t, "ci-bot", req.Executor)
+		assert.Equal(t, "job #1024", req.Remark)
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/test_plan/execute_test_case.json"))
+	}))
+
+	result, _, err := client.TestPlanService.ExecuteTestCase(ctx, &ExecuteTestCaseRequest{
+		WorkspaceID:  Ptr(11112222),
+		TestPlanID:   Ptr[int64](1111112222001000031),
+		TcaseID:      Ptr[int64](1111112222001000101),
+		ResultStatus: Ptr(TestResultStatusPass),
+		Executor:     Ptr("ci-bot"),
+		Remark:       Ptr("job #1024"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "1111112222001000501", result.ID)
+	assert.Equal(t, "1111112222001000101", result.TcaseID)
+	assert.Equal(t, TestResultStatusPass, result.ResultStatus)
+	assert.Equal(t, "2025-01-06 02:00:00", result.Created)
+}
+
+func TestTestPlanService_GetTestPlanResults(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/test_plans/get_test_results", r.URL.Path)
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+		assert.Equal(t, "1111112222001000031", r.URL.Query().Get("test_plan_id"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/test_plan/get_test_plan_results.json"))
+	}))
+
+	results, _, err := client.TestPlanService.GetTestPlanResults(ctx, &GetTestPlanResultsRequest{
+		WorkspaceID: Ptr(11112222),
+		TestPlanID:  Ptr[int64](1111112222001000031),
+	})
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	assert.Equal(t, TestResultStatusPass, results[0].ResultStatus)
+	assert.Equal(t, TestResultStatusNoPass, results[1].ResultStatus)
+}
+
+func TestTestPlanService_GetTestPlanProgress(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/test_plans/progress", r.URL.Path)
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+		assert.Equal(t, "1111112222001000031", r.URL.Query().Get("test_plan_id"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/test_plan/get_test_plan_progress.json"))
+	}))
+
+	progress, _, err := client.TestPlanService.GetTestPlanProgress(ctx, &GetTestPlanProgressRequest{
+		WorkspaceID: Ptr(11112222),
+		TestPlanID:  Ptr[int64](1111112222001000031),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, &TestPlanProgress{
+		Total:      20,
+		Executed:   12,
+		Unexecuted: 8,
+		Pass:       10,
+		NoPass:     1,
+		Block:      1,
+	}, progress)
+}
+
+func TestTestPlanService_GetTestPlanTestCaseRelations(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/test_plans/tcase_relations", r.URL.Path)
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+		assert.Equal(t, "1111112222001000031", r.URL.Query().Get("test_plan_id"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/test_plan/get_test_plan_test_case_relations.json"))
+	}))
+
+	relations, _, err := client.TestPlanService.GetTestPlanTestCaseRelations(ctx, &GetTestPlanTestCaseRelationsRequest{
+		WorkspaceID: Ptr(11112222),
+		TestPlanID:  Ptr[int64](1111112222001000031),
+	})
+	require.NoError(t, err)
+	require.Len(t, relations, 2)
+	assert.Equal(t, TestResultStatusPass, relations[0].ResultStatus)
+	assert.Equal(t, TestResultStatusNoPass, relations[1].ResultStatus)
+}
+
+func TestTestPlanService_GetTestPlans(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/test_plans", r.URL.Path)
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+		assert.Equal(t, "open", r.URL.Query().Get("status"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/test_plan/get_test_plans.json"))
+	}))
+
+	plans, _, err := client.TestPlanService.GetTestPlans(ctx, &GetTestPlansRequest{
+		WorkspaceID: Ptr(11112222),
+		Status:      NewEnum(TestPlanStatusOpen),
+	})
+	require.NoError(t, err)
+	require.Len(t, plans, 1)
+	assert.Equal(t, "1111112222001000031", plans[0].ID)
+	assert.Equal(t, "张三", plans[0].Owner)
+	assert.Equal(t, "回归测试", plans[0].Type)
+}
+
+func TestTestPlanService_GetTestPlansCount(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/test_plans/count", r.URL.Path)
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/test_plan/get_test_plans_count.json"))
+	}))
+
+	count, _, err := client.TestPlanService.GetTestPlansCount(ctx, &GetTestPlansCountRequest{
+		WorkspaceID: Ptr(11112222),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+}
+
+func TestTestPlanService_UpdateTestPlan(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/test_plans", r.URL.Path)
+
+		var req struct {
+			WorkspaceID int            `json:"workspace_id"`
+			ID          int64          `json:"id"`
+			Status      TestPlanStatus `json:"status"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, 11112222, req.WorkspaceID)
+		assert.Equal(t, int64(1111112222001000031), req.ID)
+		assert.Equal(t, TestPlanStatusDone, req.Status)
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/test_plan/update_test_plan.json"))
+	}))
+
+	plan, _, err := client.TestPlanService.UpdateTestPlan(ctx, &UpdateTestPlanRequest{
+		WorkspaceID: Ptr(11112222),
+		ID:          Ptr[int64](1111112222001000031),
+		Status:      Ptr(TestPlanStatusDone),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "1111112222001000031", plan.ID)
+	assert.Equal(t, TestPlanStatusDone, plan.Status)
+	assert.Equal(t, "李四", plan.Modifier)
+}
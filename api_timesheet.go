@@ -82,10 +82,10 @@ type GetTimesheetsRequest struct {
 	Timespent *string `url:"timespent,omitempty"`
 
 	// [可选]花费日期 支持时间查询
-	Spentdate *string `url:"spentdate,omitempty"`
+	Spentdate *TimeQuery `url:"spentdate,omitempty"`
 
 	// [可选]最后修改时间 支持时间查询
-	Modified *string `url:"modified,omitempty"`
+	Modified *TimeQuery `url:"modified,omitempty"`
 
 	// [可选]花费创建人
 	Owner *string `url:"owner,omitempty"`
@@ -94,7 +94,7 @@ type GetTimesheetsRequest struct {
 	IncludeParentStoryTimesheet *int `url:"include_parent_story_timesheet,omitempty"`
 
 	// [可选]创建时间 支持时间查询
-	Created *string `url:"created,omitempty"`
+	Created *TimeQuery `url:"created,omitempty"`
 
 	// [可选]花费描述
 	Memo *string `url:"memo,omitempty"`
@@ -162,10 +162,10 @@ type GetTimesheetsCountRequest struct {
 	Timespent *string `url:"timespent,omitempty"`
 
 	// [可选]花费日期 支持时间查询
-	Spentdate *string `url:"spentdate,omitempty"`
+	Spentdate *TimeQuery `url:"spentdate,omitempty"`
 
 	// [可选]最后修改时间 支持时间查询
-	Modified *string `url:"modified,omitempty"`
+	Modified *TimeQuery `url:"modified,omitempty"`
 
 	// [可选]花费创建人
 	Owner *string `url:"owner,omitempty"`
@@ -174,7 +174,7 @@ type GetTimesheetsCountRequest struct {
 	IncludeParentStoryTimesheet *int `url:"include_parent_story_timesheet,omitempty"`
 
 	// [可选]创建时间 支持时间查询
-	Created *string `url:"created,omitempty"`
+	Created *TimeQuery `url:"created,omitempty"`
 
 	// [可选]花费描述
 	Memo *string `url:"memo,omitempty"`
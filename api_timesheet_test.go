@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/davecgh/go-spew/spew"
 	"github.com/stretchr/testify/assert"
@@ -90,11 +91,11 @@ func TestTimesheetService_GetTimesheets(t *testing.T) {
 		EntityType:                  Ptr(EntityTypeStory),
 		EntityID:                    Ptr(111111222222),
 		Timespent:                   Ptr("2"),
-		Spentdate:                   Ptr("2024-08-22"),
-		Modified:                    Ptr("2024-08-22"),
+		Spentdate:                   NewTimeQuery("2024-08-22"),
+		Modified:                    NewTimeQuery("2024-08-22"),
 		Owner:                       Ptr("1"),
 		IncludeParentStoryTimesheet: Ptr(1),
-		Created:                     Ptr("2024-08-22"),
+		Created:                     NewTimeQuery("2024-08-22"),
 		Memo:                        Ptr("1"),
 		IsDelete:                    Ptr(0),
 		Limit:                       Ptr(10),
@@ -143,11 +144,11 @@ func TestTimesheetService_GetTimesheetsCount(t *testing.T) {
 		EntityType:                  Ptr(EntityTypeStory),
 		EntityID:                    Ptr(111111222222),
 		Timespent:                   Ptr("2"),
-		Spentdate:                   Ptr("2024-08-22"),
-		Modified:                    Ptr("2024-08-22"),
+		Spentdate:                   NewTimeQuery("2024-08-22"),
+		Modified:                    NewTimeQuery("2024-08-22"),
 		Owner:                       Ptr("1"),
 		IncludeParentStoryTimesheet: Ptr(1),
-		Created:                     Ptr("2024-08-22"),
+		Created:                     NewTimeQuery("2024-08-22"),
 		Memo:                        Ptr("1"),
 		IsDelete:                    Ptr(0),
 	})
@@ -155,6 +156,30 @@ func TestTimesheetService_GetTimesheetsCount(t *testing.T) {
 	assert.Equal(t, 6, count)
 }
 
+func TestTimesheetService_GetTimesheetsCount_TimeQuery(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/timesheets/count", r.URL.Path)
+		assert.Equal(t, "2024-08-01~2024-08-22", r.URL.Query().Get("spentdate"))
+		assert.Equal(t, ">2024-08-01 10:30:00", r.URL.Query().Get("modified"))
+		assert.Equal(t, "<2024-08-22", r.URL.Query().Get("created"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/timesheet/get_timesheets_count.json"))
+	}))
+
+	count, _, err := client.TimesheetService.GetTimesheetsCount(ctx, &GetTimesheetsCountRequest{
+		WorkspaceID: Ptr(11112222),
+		Spentdate: Between(
+			time.Date(2024, 8, 1, 0, 0, 0, 0, Location),
+			time.Date(2024, 8, 22, 0, 0, 0, 0, Location),
+		),
+		Modified: After(time.Date(2024, 8, 1, 10, 30, 0, 0, Location)),
+		Created:  Before(time.Date(2024, 8, 22, 0, 0, 0, 0, Location)),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 6, count)
+}
+
 func TestTimesheetService_UpdateTimesheet(t *testing.T) {
 	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(t, http.MethodPost, r.Method)
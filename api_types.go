@@ -4,7 +4,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/url"
+	"reflect"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/google/go-querystring/query"
 )
@@ -82,25 +85,239 @@ func NewEnum[T any](values ...T) *Enum[T] {
 
 func (e Enum[T]) EncodeValues(key string, v *url.Values) error {
 	if len(e) > 0 {
-		values := make([]string, 0, len(e))
-		for _, value := range e {
-			values = append(values, fmt.Sprint(value))
-		}
-		v.Add(key, strings.Join(values, "|"))
+		v.Add(key, e.String())
 	}
 	return nil
 }
 
+func (e Enum[T]) String() string {
+	values := make([]string, 0, len(e))
+	for _, value := range e {
+		values = append(values, fmt.Sprint(value))
+	}
+	return strings.Join(values, "|")
+}
+
 func (e Enum[T]) MarshalJSON() ([]byte, error) {
 	if len(e) <= 0 {
 		return json.Marshal(nil)
 	}
+	return json.Marshal(e.String())
+}
 
-	values := make([]string, 0, len(e))
-	for _, value := range e {
-		values = append(values, fmt.Sprint(value))
+// -----------------------------------------------------------------------------
+// Query is a type for query values with operators.
+// Eq(NewEnum("new", "reopened")) => new|reopened
+// Not("closed") => <>closed
+// Not(NewEnum("closed", "rejected")) => <>closed|rejected
+// Like("登录") => LIKE<登录>
+// IsEmpty() => EMPTY
+//
+// An empty Query, e.g. Not(""), is not encoded.
+// -----------------------------------------------------------------------------
+
+type Query struct {
+	expr string
+}
+
+var (
+	_ query.Encoder  = (*Query)(nil)
+	_ json.Marshaler = (*Query)(nil)
+)
+
+// Eq matches the value as is. The value may be an Enum to match several
+// values, which also converts an Enum for the filters of type *Query.
+//
+// Example:
+//
+//	Eq("1111112222001000001") => "1111112222001000001"
+//	Eq(NewEnum("new", "reopened")) => "new|reopened"
+func Eq(value any) *Query {
+	return &Query{expr: queryString(value)}
+}
+
+// Not matches anything but the value (支持不等于查询). The value may be an Enum
+// to exclude several values.
+//
+// Example:
+//
+//	Not("closed") => "<>closed"
+//	Not(NewEnum("closed", "rejected")) => "<>closed|rejected"
+//	Not(IsEmpty()) => "<>EMPTY"
+func Not(value any) *Query {
+	return newQuery("<>", queryString(value), "")
+}
+
+// Like matches values containing keyword (支持模糊匹配).
+//
+// Example:
+//
+//	Like("登录") => "LIKE<登录>"
+func Like(keyword string) *Query {
+	return newQuery("LIKE<", keyword, ">")
+}
+
+// IsEmpty matches empty values.
+//
+// Example:
+//
+//	IsEmpty() => "EMPTY"
+func IsEmpty() *Query {
+	return &Query{expr: "EMPTY"}
+}
+
+// IsNotEmpty matches non-empty values, same as Not(IsEmpty()).
+func IsNotEmpty() *Query {
+	return Not(IsEmpty())
+}
+
+// newQuery wraps value with the operator, or returns an empty Query for an
+// empty value.
+func newQuery(prefix, value, suffix string) *Query {
+	if value == "" {
+		return &Query{}
+	}
+	return &Query{expr: prefix + value + suffix}
+}
+
+func queryString(value any) string {
+	if rv := reflect.ValueOf(value); !rv.IsValid() || (rv.Kind() == reflect.Pointer && rv.IsNil()) {
+		return ""
+	}
+	if s, ok := value.(fmt.Stringer); ok {
+		return s.String()
+	}
+	return fmt.Sprint(value)
+}
+
+func (q *Query) String() string {
+	if q == nil {
+		return ""
+	}
+	return q.expr
+}
+
+func (q *Query) EncodeValues(key string, v *url.Values) error {
+	if q != nil && q.expr != "" {
+		v.Add(key, q.expr)
+	}
+	return nil
+}
+
+func (q *Query) MarshalJSON() ([]byte, error) {
+	if q == nil || q.expr == "" {
+		return json.Marshal(nil)
 	}
-	return json.Marshal(strings.Join(values, "|"))
+	return json.Marshal(q.expr)
+}
+
+// -----------------------------------------------------------------------------
+// TimeQuery is a type for time range queries (支持时间查询).
+// After(t) => >2024-01-01
+// Between(t1, t2) => 2024-01-01~2024-02-01
+// -----------------------------------------------------------------------------
+
+type TimeQuery struct {
+	expr string
+}
+
+var (
+	_ query.Encoder  = (*TimeQuery)(nil)
+	_ json.Marshaler = (*TimeQuery)(nil)
+)
+
+// NewTimeQuery creates a time query from a raw expression of the TAPD syntax.
+//
+// Example:
+//
+//	NewTimeQuery(">=2024-01-01") => ">=2024-01-01"
+func NewTimeQuery(expr string) *TimeQuery {
+	return &TimeQuery{expr: expr}
+}
+
+// On matches times on the date of t.
+//
+// Example:
+//
+//	On(2024-01-01 10:00:00) => "2024-01-01"
+func On(t time.Time) *TimeQuery {
+	return &TimeQuery{expr: t.In(Location).Format(time.DateOnly)}
+}
+
+// Between matches times from from to to, both inclusive.
+//
+// Example:
+//
+//	Between(2024-01-01, 2024-02-01) => "2024-01-01~2024-02-01"
+func Between(from, to time.Time) *TimeQuery {
+	return &TimeQuery{expr: formatQueryTime(from) + "~" + formatQueryTime(to)}
+}
+
+// After matches times after t.
+//
+// Example:
+//
+//	After(2024-01-01) => ">2024-01-01"
+func After(t time.Time) *TimeQuery {
+	return &TimeQuery{expr: ">" + formatQueryTime(t)}
+}
+
+// Before matches times before t.
+//
+// Example:
+//
+//	Before(2024-01-01) => "<2024-01-01"
+func Before(t time.Time) *TimeQuery {
+	return &TimeQuery{expr: "<" + formatQueryTime(t)}
+}
+
+// Since matches times at or after t.
+//
+// Example:
+//
+//	Since(2024-01-01) => ">=2024-01-01"
+func Since(t time.Time) *TimeQuery {
+	return &TimeQuery{expr: ">=" + formatQueryTime(t)}
+}
+
+// Until matches times at or before t.
+//
+// Example:
+//
+//	Until(2024-01-01) => "<=2024-01-01"
+func Until(t time.Time) *TimeQuery {
+	return &TimeQuery{expr: "<=" + formatQueryTime(t)}
+}
+
+// formatQueryTime formats t in the time zone of TAPD, as a date when t is at
+// midnight and as a time otherwise.
+func formatQueryTime(t time.Time) string {
+	t = t.In(Location)
+	if t.Hour() == 0 && t.Minute() == 0 && t.Second() == 0 {
+		return t.Format(time.DateOnly)
+	}
+	return t.Format(TimeLayout)
+}
+
+func (q *TimeQuery) String() string {
+	if q == nil {
+		return ""
+	}
+	return q.expr
+}
+
+func (q *TimeQuery) EncodeValues(key string, v *url.Values) error {
+	if s := q.String(); s != "" {
+		v.Add(key, s)
+	}
+	return nil
+}
+
+func (q *TimeQuery) MarshalJSON() ([]byte, error) {
+	if s := q.String(); s != "" {
+		return json.Marshal(s)
+	}
+	return json.Marshal(nil)
 }
 
 // -----------------------------------------------------------------------------
@@ -244,3 +461,298 @@ const (
 	OperateObjectTestPlan          OperateObject = "testplan"
 	OperateObjectWiki              OperateObject = "wiki"
 )
+
+// -----------------------------------------------------------------------------
+// Time is a time returned by TAPD.
+//
+// TAPD returns times as "2006-01-02 15:04:05" or dates as "2006-01-02" in the
+// Asia/Shanghai time zone. Empty strings and "0000-00-00" are the zero time.
+// -----------------------------------------------------------------------------
+
+// TimeLayout is the layout of the times returned by TAPD.
+const TimeLayout = time.DateTime
+
+// Location is the time zone of the times returned by TAPD.
+var Location = loadLocation()
+
+func loadLocation() *time.Location {
+	if loc, err := time.LoadLocation("Asia/Shanghai"); err == nil {
+		return loc
+	}
+	return time.FixedZone("CST", 8*60*60)
+}
+
+var timeLayouts = []string{TimeLayout, "2006-01-02 15:04", time.DateOnly}
+
+type Time struct {
+	time.Time
+}
+
+var (
+	_ json.Marshaler   = (*Time)(nil)
+	_ json.Unmarshaler = (*Time)(nil)
+)
+
+// ParseTime parses a time or date returned by TAPD.
+//
+// Example:
+//
+//	ParseTime("2024-08-31 10:20:30") => 2024-08-31 10:20:30 +0800 CST
+//	ParseTime("2024-08-31") => 2024-08-31 00:00:00 +0800 CST
+//	ParseTime("") => zero time
+func ParseTime(s string) (Time, error) {
+	s = strings.TrimSpace(s)
+	if s == "" || strings.HasPrefix(s, "0000-00-00") {
+		return Time{}, nil
+	}
+
+	var err error
+	for _, layout := range timeLayouts {
+		var t time.Time
+		if t, err = time.ParseInLocation(layout, s, Location); err == nil {
+			return Time{Time: t}, nil
+		}
+	}
+	return Time{}, err
+}
+
+// String returns the time in the TAPD layout, or an empty string for the zero
+// time.
+func (t Time) String() string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.In(Location).Format(TimeLayout)
+}
+
+func (t Time) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.String())
+}
+
+func (t *Time) UnmarshalJSON(data []byte) error {
+	var s *string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	if s == nil {
+		*t = Time{}
+		return nil
+	}
+
+	parsed, err := ParseTime(*s)
+	if err != nil {
+		return err
+	}
+	*t = parsed
+	return nil
+}
+
+// -----------------------------------------------------------------------------
+// Float is a number returned by TAPD, e.g. effort or progress.
+//
+// TAPD returns numbers as strings; empty strings and null are 0.
+// -----------------------------------------------------------------------------
+
+type Float float64
+
+var (
+	_ json.Marshaler   = (*Float)(nil)
+	_ json.Unmarshaler = (*Float)(nil)
+)
+
+// ParseFloat parses a number returned by TAPD. An empty string is 0.
+func ParseFloat(s string) (Float, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0, nil
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	return Float(f), err
+}
+
+func (f Float) Float64() float64 {
+	return float64(f)
+}
+
+func (f Float) String() string {
+	return strconv.FormatFloat(float64(f), 'f', -1, 64)
+}
+
+func (f Float) MarshalJSON() ([]byte, error) {
+	return json.Marshal(float64(f))
+}
+
+func (f *Float) UnmarshalJSON(data []byte) error {
+	parsed, err := ParseFloat(unquoteNumber(data))
+	if err != nil {
+		return err
+	}
+	*f = parsed
+	return nil
+}
+
+// -----------------------------------------------------------------------------
+// Int is an integer returned by TAPD, e.g. an ID.
+//
+// TAPD returns integers as strings; empty strings and null are 0.
+// -----------------------------------------------------------------------------
+
+type Int int64
+
+var (
+	_ json.Marshaler   = (*Int)(nil)
+	_ json.Unmarshaler = (*Int)(nil)
+)
+
+// ParseInt parses an integer returned by TAPD. An empty string is 0.
+func ParseInt(s string) (Int, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0, nil
+	}
+	i, err := strconv.ParseInt(s, 10, 64)
+	return Int(i), err
+}
+
+func (i Int) Int64() int64 {
+	return int64(i)
+}
+
+func (i Int) String() string {
+	return strconv.FormatInt(int64(i), 10)
+}
+
+func (i Int) MarshalJSON() ([]byte, error) {
+	return json.Marshal(int64(i))
+}
+
+func (i *Int) UnmarshalJSON(data []byte) error {
+	parsed, err := ParseInt(unquoteNumber(data))
+	if err != nil {
+		return err
+	}
+	*i = parsed
+	return nil
+}
+
+// unquoteNumber returns a JSON number or string as a string, and null as an
+// empty string.
+func unquoteNumber(data []byte) string {
+	s := string(data)
+	if s == "null" {
+		return ""
+	}
+	if unquoted, err := strconv.Unquote(s); err == nil {
+		return unquoted
+	}
+	return s
+}
+
+// -----------------------------------------------------------------------------
+// Users is a list of users, e.g. the owners of a story.
+// Users{"alice", "bob"} <=> "alice;bob;"
+// -----------------------------------------------------------------------------
+
+type Users []string
+
+var (
+	_ json.Marshaler   = (*Users)(nil)
+	_ json.Unmarshaler = (*Users)(nil)
+)
+
+// ParseUsers parses users separated by semicolons (or commas), ignoring
+// empty names.
+//
+// Example:
+//
+//	ParseUsers("alice;bob;") => Users{"alice", "bob"}
+func ParseUsers(s string) Users {
+	return splitNonEmpty(s, ";,")
+}
+
+func (u Users) String() string {
+	if len(u) == 0 {
+		return ""
+	}
+	return strings.Join(u, ";") + ";"
+}
+
+func (u Users) MarshalJSON() ([]byte, error) {
+	return json.Marshal(u.String())
+}
+
+func (u *Users) UnmarshalJSON(data []byte) error {
+	values, err := unmarshalList(data, ParseUsers)
+	if err != nil {
+		return err
+	}
+	*u = values
+	return nil
+}
+
+// -----------------------------------------------------------------------------
+// Labels is a list of labels.
+// Labels{"a", "b"} <=> "a|b"
+// -----------------------------------------------------------------------------
+
+type Labels []string
+
+var (
+	_ json.Marshaler   = (*Labels)(nil)
+	_ json.Unmarshaler = (*Labels)(nil)
+)
+
+// ParseLabels parses labels separated by vertical bars, ignoring empty labels.
+//
+// Example:
+//
+//	ParseLabels("a|b") => Labels{"a", "b"}
+func ParseLabels(s string) Labels {
+	return splitNonEmpty(s, "|")
+}
+
+func (l Labels) String() string {
+	return strings.Join(l, "|")
+}
+
+func (l Labels) MarshalJSON() ([]byte, error) {
+	return json.Marshal(l.String())
+}
+
+func (l *Labels) UnmarshalJSON(data []byte) error {
+	values, err := unmarshalList(data, ParseLabels)
+	if err != nil {
+		return err
+	}
+	*l = values
+	return nil
+}
+
+// unmarshalList decodes a JSON string with parse, or a JSON array as is.
+func unmarshalList[T ~[]string](data []byte, parse func(string) T) (T, error) {
+	var values []string
+	if err := json.Unmarshal(data, &values); err == nil {
+		return values, nil
+	}
+
+	var s *string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, err
+	}
+	if s == nil {
+		return nil, nil
+	}
+	return parse(*s), nil
+}
+
+func splitNonEmpty(s, separators string) []string {
+	var values []string
+	for _, value := range strings.FieldsFunc(s, func(r rune) bool {
+		return strings.ContainsRune(separators, r)
+	}) {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
@@ -5,8 +5,11 @@ import (
 	"fmt"
 	"net/url"
 	"testing"
+	"time"
 
+	"github.com/google/go-querystring/query"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestTypes_Multi(t *testing.T) {
@@ -119,3 +122,207 @@ func TestTypes_Order_Custom(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, `{"order":"id asc"}`, string(bytes))
 }
+
+func TestTypes_Time(t *testing.T) {
+	tm, err := ParseTime("2024-08-31 10:20:30")
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 8, 31, 10, 20, 30, 0, Location), tm.Time)
+	assert.Equal(t, "2024-08-31 10:20:30", tm.String())
+
+	tm, err = ParseTime("2024-08-31")
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 8, 31, 0, 0, 0, 0, Location), tm.Time)
+
+	for _, s := range []string{"", "0000-00-00", "0000-00-00 00:00:00"} {
+		tm, err = ParseTime(s)
+		require.NoError(t, err)
+		assert.True(t, tm.IsZero())
+	}
+
+	_, err = ParseTime("yesterday")
+	assert.Error(t, err)
+
+	// json
+	var v struct {
+		Created  Time `json:"created"`
+		Modified Time `json:"modified"`
+		Due      Time `json:"due"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(`{"created":"2024-08-31 10:20:30","modified":null,"due":""}`), &v))
+	assert.Equal(t, "2024-08-31 10:20:30", v.Created.String())
+	assert.True(t, v.Modified.IsZero())
+	assert.True(t, v.Due.IsZero())
+	assert.Error(t, json.Unmarshal([]byte(`{"created":"bad"}`), &v))
+
+	data, err := json.Marshal(v)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"created":"2024-08-31 10:20:30","modified":"","due":""}`, string(data))
+}
+
+func TestTypes_Float(t *testing.T) {
+	var v struct {
+		Effort   Float `json:"effort"`
+		Remain   Float `json:"remain"`
+		Progress Float `json:"progress"`
+		Exceed   Float `json:"exceed"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(`{"effort":"1.5","remain":"","progress":80,"exceed":null}`), &v))
+	assert.Equal(t, 1.5, v.Effort.Float64())
+	assert.Equal(t, Float(0), v.Remain)
+	assert.Equal(t, Float(80), v.Progress)
+	assert.Equal(t, Float(0), v.Exceed)
+	assert.Equal(t, "1.5", v.Effort.String())
+	assert.Error(t, json.Unmarshal([]byte(`{"effort":"abc"}`), &v))
+
+	f, err := ParseFloat(" 2.25 ")
+	require.NoError(t, err)
+	assert.Equal(t, Float(2.25), f)
+
+	data, err := json.Marshal(Float(2.5))
+	require.NoError(t, err)
+	assert.Equal(t, "2.5", string(data))
+}
+
+func TestTypes_Int(t *testing.T) {
+	var v struct {
+		ID       Int `json:"id"`
+		ParentID Int `json:"parent_id"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(`{"id":"1111112222001000001","parent_id":""}`), &v))
+	assert.Equal(t, int64(1111112222001000001), v.ID.Int64())
+	assert.Equal(t, Int(0), v.ParentID)
+	assert.Equal(t, "1111112222001000001", v.ID.String())
+	assert.Error(t, json.Unmarshal([]byte(`{"id":"1.5"}`), &v))
+}
+
+func TestTypes_Users(t *testing.T) {
+	assert.Equal(t, Users{"alice", "bob"}, ParseUsers("alice;bob;"))
+	assert.Equal(t, Users{"alice", "bob"}, ParseUsers("alice, bob"))
+	assert.Nil(t, ParseUsers(""))
+	assert.Equal(t, "alice;bob;", Users{"alice", "bob"}.String())
+	assert.Equal(t, "", Users{}.String())
+
+	var v struct {
+		Owner Users `json:"owner"`
+		CC    Users `json:"cc"`
+		De    Users `json:"de"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(`{"owner":"alice;bob;","cc":["carol"],"de":null}`), &v))
+	assert.Equal(t, Users{"alice", "bob"}, v.Owner)
+	assert.Equal(t, Users{"carol"}, v.CC)
+	assert.Nil(t, v.De)
+
+	data, err := json.Marshal(v)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"owner":"alice;bob;","cc":"carol;","de":""}`, string(data))
+}
+
+func TestTypes_Labels(t *testing.T) {
+	assert.Equal(t, Labels{"a", "b"}, ParseLabels("a|b|"))
+	assert.Nil(t, ParseLabels(""))
+	assert.Equal(t, "a|b", Labels{"a", "b"}.String())
+
+	var v struct {
+		Label Labels `json:"label"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(`{"label":"重要|紧急"}`), &v))
+	assert.Equal(t, Labels{"重要", "紧急"}, v.Label)
+	assert.Error(t, json.Unmarshal([]byte(`{"label":1}`), &v))
+
+	data, err := json.Marshal(v)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"label":"重要|紧急"}`, string(data))
+}
+
+func TestTypes_TimeQuery(t *testing.T) {
+	day1 := time.Date(2024, 1, 1, 0, 0, 0, 0, Location)
+	day2 := time.Date(2024, 2, 1, 0, 0, 0, 0, Location)
+	noon := time.Date(2024, 1, 1, 12, 30, 0, 0, Location)
+
+	assert.Equal(t, "2024-01-01~2024-02-01", Between(day1, day2).String())
+	assert.Equal(t, ">2024-01-01", After(day1).String())
+	assert.Equal(t, "<2024-01-01", Before(day1).String())
+	assert.Equal(t, ">=2024-01-01", Since(day1).String())
+	assert.Equal(t, "<=2024-01-01 12:30:00", Until(noon).String())
+	assert.Equal(t, "2024-01-01", On(noon).String())
+	assert.Equal(t, ">=2024-01-01", NewTimeQuery(">=2024-01-01").String())
+
+	// converted to the time zone of TAPD
+	assert.Equal(t, ">2024-01-01", After(time.Date(2023, 12, 31, 16, 0, 0, 0, time.UTC)).String())
+
+	values := &url.Values{}
+	assert.NoError(t, After(day1).EncodeValues("created", values))
+	assert.NoError(t, (*TimeQuery)(nil).EncodeValues("modified", values))
+	assert.Equal(t, ">2024-01-01", values.Get("created"))
+	assert.False(t, values.Has("modified"))
+
+	data, err := json.Marshal(struct {
+		Created  *TimeQuery `json:"created"`
+		Modified *TimeQuery `json:"modified"`
+	}{Created: Between(day1, day2)})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"created":"2024-01-01~2024-02-01","modified":null}`, string(data))
+}
+
+func TestTypes_Query(t *testing.T) {
+	assert.Equal(t, "1111112222001000001", Eq(int64(1111112222001000001)).String())
+	assert.Equal(t, "new|reopened", Eq(NewEnum("new", "reopened")).String())
+	assert.Equal(t, "<>closed", Not("closed").String())
+	assert.Equal(t, "<>closed|rejected", Not(NewEnum("closed", "rejected")).String())
+	assert.Equal(t, "LIKE<登录>", Like("登录").String())
+	assert.Equal(t, "EMPTY", IsEmpty().String())
+	assert.Equal(t, "<>EMPTY", IsNotEmpty().String())
+	assert.Equal(t, "", (*Query)(nil).String())
+
+	values, err := query.Values(&GetBugsRequest{
+		WorkspaceID: Ptr(11112222),
+		Status:      Not(NewEnum("closed", "rejected")),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "<>closed|rejected", values.Get("status"))
+
+	values, err = query.Values(&GetStoriesRequest{IterationID: Eq("1111112222001000001")})
+	require.NoError(t, err)
+	assert.Equal(t, "1111112222001000001", values.Get("iteration_id"))
+
+	values, err = query.Values(&GetStoriesRequest{IterationID: IsEmpty()})
+	require.NoError(t, err)
+	assert.Equal(t, "EMPTY", values.Get("iteration_id"))
+
+	values, err = query.Values(&GetBugsRequest{Status: Like("reopen")})
+	require.NoError(t, err)
+	assert.Equal(t, "LIKE<reopen>", values.Get("status"))
+
+	// empty values are skipped
+	values, err = query.Values(&GetBugsRequest{
+		Status:      Not((*Enum[string])(nil)),
+		IterationID: NewEnum[string](),
+	})
+	require.NoError(t, err)
+	assert.Empty(t, values)
+
+	values, err = query.Values(&GetStoriesRequest{IterationID: Not("")})
+	require.NoError(t, err)
+	assert.Empty(t, values)
+
+	values, err = query.Values(&GetBugsRequest{Status: Like("")})
+	require.NoError(t, err)
+	assert.Empty(t, values)
+
+	// typed nil values are skipped
+	values, err = query.Values(&GetBugsRequest{
+		Status:      (*Query)(nil),
+		IterationID: (*Enum[string])(nil),
+		Fields:      (*Multi[string])(nil),
+	})
+	require.NoError(t, err)
+	assert.Empty(t, values)
+
+	data, err := json.Marshal(struct {
+		Status *Query `json:"status"`
+		Owner  *Query `json:"owner"`
+		Name   *Query `json:"name"`
+	}{Status: Not("closed"), Name: Not("")})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"status":"<>closed","owner":null,"name":null}`, string(data))
+}
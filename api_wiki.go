@@ -0,0 +1,530 @@
+package tapd
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+)
+
+// Wiki Wiki 文档
+type Wiki struct {
+	ID                  string `json:"id,omitempty"`                   // ID
+	WorkspaceID         string `json:"workspace_id,omitempty"`         // 项目ID
+	Name                string `json:"name,omitempty"`                 // 标题
+	Description         string `json:"description,omitempty"`          // 富文本内容
+	MarkdownDescription string `json:"markdown_description,omitempty"` // Markdown 内容
+	Note                string `json:"note,omitempty"`                 // 备注
+	ParentWikiID        string `json:"parent_wiki_id,omitempty"`       // 父 Wiki ID
+	ViewCount           string `json:"view_count,omitempty"`           // 浏览次数
+	IsPublic            string `json:"is_public,omitempty"`            // 是否公开
+	Creator             string `json:"creator,omitempty"`              // 创建人
+	Modifier            string `json:"modifier,omitempty"`             // 最后修改人
+	Created             string `json:"created,omitempty"`              // 创建时间
+	Modified            string `json:"modified,omitempty"`             // 最后修改时间
+}
+
+// WikiDrawio Wiki 中的 drawio 图
+type WikiDrawio struct {
+	ID          string `json:"id,omitempty"`           // ID
+	WorkspaceID string `json:"workspace_id,omitempty"` // 项目ID
+	WikiID      string `json:"wiki_id,omitempty"`      // Wiki ID
+	Name        string `json:"name,omitempty"`         // 名称
+	Data        string `json:"data,omitempty"`         // drawio XML 数据
+	Creator     string `json:"creator,omitempty"`      // 创建人
+	Created     string `json:"created,omitempty"`      // 创建时间
+	Modified    string `json:"modified,omitempty"`     // 最后修改时间
+}
+
+// WikiFollower Wiki 关注人
+type WikiFollower struct {
+	ID          string `json:"id,omitempty"`           // ID
+	WorkspaceID string `json:"workspace_id,omitempty"` // 项目ID
+	WikiID      string `json:"wiki_id,omitempty"`      // Wiki ID
+	Follower    string `json:"follower,omitempty"`     // 关注人
+	Created     string `json:"created,omitempty"`      // 关注时间
+}
+
+// WikiAccessScope Wiki 可访问范围
+type WikiAccessScope struct {
+	Users      []string               `json:"users,omitempty"`       // 可访问人员
+	UserGroups []*WikiAccessUserGroup `json:"user_groups,omitempty"` // 可访问用户组
+}
+
+// WikiAccessUserGroup Wiki 可访问用户组
+type WikiAccessUserGroup struct {
+	ID   string `json:"id,omitempty"`   // 用户组ID
+	Name string `json:"name,omitempty"` // 用户组名称
+}
+
+// WikiTag Wiki 标签
+type WikiTag struct {
+	ID          string `json:"id,omitempty"`           // ID
+	WorkspaceID string `json:"workspace_id,omitempty"` // 项目ID
+	WikiID      string `json:"wiki_id,omitempty"`      // Wiki ID
+	Name        string `json:"name,omitempty"`         // 标签名称
+	Creator     string `json:"creator,omitempty"`      // 创建人
+	Created     string `json:"created,omitempty"`      // 创建时间
+}
+
+// WikiService Wiki 服务
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/tapd_wiki/
+type WikiService struct {
+	client *Client
+}
+
+// -----------------------------------------------------------------------------
+// 创建 wiki
+// -----------------------------------------------------------------------------
+
+type CreateWikiRequest struct {
+	WorkspaceID         *int    `json:"workspace_id,omitempty"`         // [必须]项目ID
+	Name                *string `json:"name,omitempty"`                 // [必须]标题
+	Creator             *string `json:"creator,omitempty"`              // [必须]创建人
+	MarkdownDescription *string `json:"markdown_description,omitempty"` // Markdown 内容
+	Note                *string `json:"note,omitempty"`                 // 备注
+	ParentWikiID        *int64  `json:"parent_wiki_id,omitempty"`       // 父 Wiki ID
+}
+
+// CreateWiki 创建 wiki
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/tapd_wiki/add_tapd_wiki.html
+func (s *WikiService) CreateWiki(
+	ctx context.Context, request *CreateWikiRequest, opts ...RequestOption,
+) (*Wiki, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "tapd_wikis", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var item struct {
+		Wiki *Wiki `json:"Wiki"`
+	}
+	resp, err := s.client.Do(req, &item)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return item.Wiki, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 获取 wiki
+// -----------------------------------------------------------------------------
+
+type GetWikisRequest struct {
+	WorkspaceID  *int           `url:"workspace_id,omitempty"`   // [必须]项目ID
+	ID           *Multi[int64]  `url:"id,omitempty"`             // ID	支持多ID查询
+	Name         *string        `url:"name,omitempty"`           // 标题	支持模糊匹配
+	Note         *string        `url:"note,omitempty"`           // 备注
+	ParentWikiID *int64         `url:"parent_wiki_id,omitempty"` // 父 Wiki ID
+	Creator      *string        `url:"creator,omitempty"`        // 创建人
+	Modifier     *string        `url:"modifier,omitempty"`       // 最后修改人
+	Created      *TimeQuery     `url:"created,omitempty"`        // 创建时间	支持时间查询
+	Modified     *TimeQuery     `url:"modified,omitempty"`       // 最后修改时间	支持时间查询
+	Limit        *int           `url:"limit,omitempty"`          // 设置返回数量限制，默认为30
+	Page         *int           `url:"page,omitempty"`           // 返回当前数量限制下第N页的数据，默认为1（第一页）
+	Order        *Order         `url:"order,omitempty"`          // 排序规则，规则：字段名 ASC或者DESC
+	Fields       *Multi[string] `url:"fields,omitempty"`         // 设置获取的字段，多个字段间以','逗号隔开
+}
+
+// GetWikis 获取 wiki
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/tapd_wiki/get_tapd_wikis.html
+func (s *WikiService) GetWikis(
+	ctx context.Context, request *GetWikisRequest, opts ...RequestOption,
+) ([]*Wiki, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "tapd_wikis", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var items []struct {
+		Wiki *Wiki `json:"Wiki"`
+	}
+	resp, err := s.client.Do(req, &items)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	wikis := make([]*Wiki, 0, len(items))
+	for _, item := range items {
+		wikis = append(wikis, item.Wiki)
+	}
+
+	return wikis, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 获取 Wiki 数量
+// -----------------------------------------------------------------------------
+
+type GetWikisCountRequest struct {
+	WorkspaceID  *int          `url:"workspace_id,omitempty"`   // [必须]项目ID
+	ID           *Multi[int64] `url:"id,omitempty"`             // ID	支持多ID查询
+	Name         *string       `url:"name,omitempty"`           // 标题	支持模糊匹配
+	Note         *string       `url:"note,omitempty"`           // 备注
+	ParentWikiID *int64        `url:"parent_wiki_id,omitempty"` // 父 Wiki ID
+	Creator      *string       `url:"creator,omitempty"`        // 创建人
+	Modifier     *string       `url:"modifier,omitempty"`       // 最后修改人
+	Created      *TimeQuery    `url:"created,omitempty"`        // 创建时间	支持时间查询
+	Modified     *TimeQuery    `url:"modified,omitempty"`       // 最后修改时间	支持时间查询
+}
+
+// GetWikisCount 获取 Wiki 数量
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/tapd_wiki/get_tapd_wikis_count.html
+func (s *WikiService) GetWikisCount(
+	ctx context.Context, request *GetWikisCountRequest, opts ...RequestOption,
+) (int, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "tapd_wikis/count", request, opts)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var response CountResponse
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return 0, resp, err
+	}
+
+	return response.Count, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 更新 wiki
+// -----------------------------------------------------------------------------
+
+type UpdateWikiRequest struct {
+	WorkspaceID         *int    `json:"workspace_id,omitempty"`         // [必须]项目ID
+	ID                  *int64  `json:"id,omitempty"`                   // [必须]ID
+	Name                *string `json:"name,omitempty"`                 // 标题
+	Modifier            *string `json:"modifier,omitempty"`             // 最后修改人
+	MarkdownDescription *string `json:"markdown_description,omitempty"` // Markdown 内容
+	Note                *string `json:"note,omitempty"`                 // 备注
+	ParentWikiID        *int64  `json:"parent_wiki_id,omitempty"`       // 父 Wiki ID
+}
+
+// UpdateWiki 更新 wiki
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/tapd_wiki/update_tapd_wiki.html
+func (s *WikiService) UpdateWiki(
+	ctx context.Context, request *UpdateWikiRequest, opts ...RequestOption,
+) (*Wiki, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "tapd_wikis", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var item struct {
+		Wiki *Wiki `json:"Wiki"`
+	}
+	resp, err := s.client.Do(req, &item)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return item.Wiki, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 按标题创建或更新 wiki
+// -----------------------------------------------------------------------------
+
+type CreateOrUpdateWikiRequest struct {
+	WorkspaceID         *int    `json:"workspace_id,omitempty"`         // [必须]项目ID
+	Name                *string `json:"name,omitempty"`                 // [必须]标题，按标题完全匹配查找已有的 wiki
+	Operator            *string `json:"operator,omitempty"`             // [必须]操作人，新建时作为创建人，更新时作为最后修改人
+	MarkdownDescription *string `json:"markdown_description,omitempty"` // Markdown 内容
+	Note                *string `json:"note,omitempty"`                 // 备注
+	ParentWikiID        *int64  `json:"parent_wiki_id,omitempty"`       // 父 Wiki ID，设置后只在该父 wiki 下查找
+}
+
+// CreateOrUpdateWiki 按标题创建或更新 wiki
+//
+// 在项目中查找标题完全一致的 wiki（TAPD 的标题查询为模糊匹配，这里会再做一次精确比较），
+// 找到时更新第一个匹配的 wiki，否则新建。适用于每次发布时将生成的文档同步到 wiki。
+func (s *WikiService) CreateOrUpdateWiki(
+	ctx context.Context, request *CreateOrUpdateWikiRequest, opts ...RequestOption,
+) (*Wiki, *Response, error) {
+	if request == nil || request.Name == nil {
+		return nil, nil, errors.New("tapd: CreateOrUpdateWiki requires a request with Name")
+	}
+	name := *request.Name
+
+	var existing *Wiki
+	for wiki, err := range All(ctx, s.GetWikis, &GetWikisRequest{
+		WorkspaceID:  request.WorkspaceID,
+		Name:         request.Name,
+		ParentWikiID: request.ParentWikiID,
+		Fields:       NewMulti("id", "name"),
+	}, WithPagerLimit(200), WithPagerRequestOptions(opts...)) {
+		if err != nil {
+			return nil, nil, err
+		}
+		if wiki.Name == name {
+			existing = wiki
+			break
+		}
+	}
+
+	if existing == nil {
+		return s.CreateWiki(ctx, &CreateWikiRequest{
+			WorkspaceID:         request.WorkspaceID,
+			Name:                request.Name,
+			Creator:             request.Operator,
+			MarkdownDescription: request.MarkdownDescription,
+			Note:                request.Note,
+			ParentWikiID:        request.ParentWikiID,
+		}, opts...)
+	}
+
+	id, err := strconv.ParseInt(existing.ID, 10, 64)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.UpdateWiki(ctx, &UpdateWikiRequest{
+		WorkspaceID:         request.WorkspaceID,
+		ID:                  &id,
+		Modifier:            request.Operator,
+		MarkdownDescription: request.MarkdownDescription,
+		Note:                request.Note,
+		ParentWikiID:        request.ParentWikiID,
+	}, opts...)
+}
+
+// -----------------------------------------------------------------------------
+// 获取wiki drawio数据
+// -----------------------------------------------------------------------------
+
+type GetWikiDrawiosRequest struct {
+	WorkspaceID *int   `url:"workspace_id,omitempty"` // [必须]项目ID
+	WikiID      *int64 `url:"wiki_id,omitempty"`      // [必须]Wiki ID
+	Limit       *int   `url:"limit,omitempty"`        // 设置返回数量限制，默认为30
+	Page        *int   `url:"page,omitempty"`         // 返回当前数量限制下第N页的数据，默认为1（第一页）
+}
+
+// GetWikiDrawios 获取wiki drawio数据
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/tapd_wiki/get_wiki_drawio.html
+func (s *WikiService) GetWikiDrawios(
+	ctx context.Context, request *GetWikiDrawiosRequest, opts ...RequestOption,
+) ([]*WikiDrawio, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "tapd_wikis/get_drawio", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var items []struct {
+		WikiDrawio *WikiDrawio `json:"WikiDrawio"`
+	}
+	resp, err := s.client.Do(req, &items)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	drawios := make([]*WikiDrawio, 0, len(items))
+	for _, item := range items {
+		drawios = append(drawios, item.WikiDrawio)
+	}
+
+	return drawios, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 获取wiki关注人数据
+// -----------------------------------------------------------------------------
+
+type GetWikiFollowersRequest struct {
+	WorkspaceID *int          `url:"workspace_id,omitempty"` // [必须]项目ID
+	WikiID      *Multi[int64] `url:"wiki_id,omitempty"`      // Wiki ID	支持多ID查询
+	Follower    *string       `url:"follower,omitempty"`     // 关注人
+	Limit       *int          `url:"limit,omitempty"`        // 设置返回数量限制，默认为30
+	Page        *int          `url:"page,omitempty"`         // 返回当前数量限制下第N页的数据，默认为1（第一页）
+}
+
+// GetWikiFollowers 获取wiki关注人数据
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/tapd_wiki/get_wiki_followers.html
+func (s *WikiService) GetWikiFollowers(
+	ctx context.Context, request *GetWikiFollowersRequest, opts ...RequestOption,
+) ([]*WikiFollower, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "tapd_wikis/followers", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var items []struct {
+		WikiFollower *WikiFollower `json:"WikiFollower"`
+	}
+	resp, err := s.client.Do(req, &items)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	followers := make([]*WikiFollower, 0, len(items))
+	for _, item := range items {
+		followers = append(followers, item.WikiFollower)
+	}
+
+	return followers, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 获取wiki关注人数量
+// -----------------------------------------------------------------------------
+
+type GetWikiFollowersCountRequest struct {
+	WorkspaceID *int          `url:"workspace_id,omitempty"` // [必须]项目ID
+	WikiID      *Multi[int64] `url:"wiki_id,omitempty"`      // Wiki ID	支持多ID查询
+	Follower    *string       `url:"follower,omitempty"`     // 关注人
+}
+
+// GetWikiFollowersCount 获取wiki关注人数量
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/tapd_wiki/get_wiki_followers_count.html
+func (s *WikiService) GetWikiFollowersCount(
+	ctx context.Context, request *GetWikiFollowersCountRequest, opts ...RequestOption,
+) (int, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "tapd_wikis/followers/count", request, opts)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var response CountResponse
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return 0, resp, err
+	}
+
+	return response.Count, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 获取wiki可访问范围人员及用户组
+// -----------------------------------------------------------------------------
+
+type GetWikiAccessScopeRequest struct {
+	WorkspaceID *int   `url:"workspace_id,omitempty"` // [必须]项目ID
+	WikiID      *int64 `url:"wiki_id,omitempty"`      // [必须]Wiki ID
+}
+
+// GetWikiAccessScope 获取wiki可访问范围人员及用户组
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/tapd_wiki/get_wiki_view_scope.html
+func (s *WikiService) GetWikiAccessScope(
+	ctx context.Context, request *GetWikiAccessScopeRequest, opts ...RequestOption,
+) (*WikiAccessScope, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "tapd_wikis/view_scope", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	scope := new(WikiAccessScope)
+	resp, err := s.client.Do(req, scope)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return scope, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 获取wiki标签信息
+// -----------------------------------------------------------------------------
+
+type GetWikiTagsRequest struct {
+	WorkspaceID *int          `url:"workspace_id,omitempty"` // [必须]项目ID
+	WikiID      *Multi[int64] `url:"wiki_id,omitempty"`      // Wiki ID	支持多ID查询
+	Name        *string       `url:"name,omitempty"`         // 标签名称
+	Limit       *int          `url:"limit,omitempty"`        // 设置返回数量限制，默认为30
+	Page        *int          `url:"page,omitempty"`         // 返回当前数量限制下第N页的数据，默认为1（第一页）
+}
+
+// GetWikiTags 获取wiki标签信息
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/tapd_wiki/get_wiki_tags.html
+func (s *WikiService) GetWikiTags(
+	ctx context.Context, request *GetWikiTagsRequest, opts ...RequestOption,
+) ([]*WikiTag, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "tapd_wikis/tags", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var items []struct {
+		WikiTag *WikiTag `json:"WikiTag"`
+	}
+	resp, err := s.client.Do(req, &items)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	tags := make([]*WikiTag, 0, len(items))
+	for _, item := range items {
+		tags = append(tags, item.WikiTag)
+	}
+
+	return tags, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 获取wiki标签信息数量
+// -----------------------------------------------------------------------------
+
+type GetWikiTagsCountRequest struct {
+	WorkspaceID *int          `url:"workspace_id,omitempty"` // [必须]项目ID
+	WikiID      *Multi[int64] `url:"wiki_id,omitempty"`      // Wiki ID	支持多ID查询
+	Name        *string       `url:"name,omitempty"`         // 标签名称
+}
+
+// GetWikiTagsCount 获取wiki标签信息数量
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/tapd_wiki/get_wiki_tags_count.html
+func (s *WikiService) GetWikiTagsCount(
+	ctx context.Context, request *GetWikiTagsCountRequest, opts ...RequestOption,
+) (int, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "tapd_wikis/tags/count", request, opts)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var response CountResponse
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return 0, resp, err
+	}
+
+	return response.Count, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 获取wiki附件数量
+// -----------------------------------------------------------------------------
+
+type GetWikiAttachmentsCountRequest struct {
+	WorkspaceID *int          `url:"workspace_id,omitempty"` // [必须]项目ID
+	WikiID      *Multi[int64] `url:"wiki_id,omitempty"`      // Wiki ID	支持多ID查询
+}
+
+// GetWikiAttachmentsCount 获取wiki附件数量
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/tapd_wiki/get_wiki_attachments_count.html
+func (s *WikiService) GetWikiAttachmentsCount(
+	ctx context.Context, request *GetWikiAttachmentsCountRequest, opts ...RequestOption,
+) (int, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "tapd_wikis/attachments/count", request, opts)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var response CountResponse
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return 0, resp, err
+	}
+
+	return response.Count, resp, nil
+}
@@ -0,0 +1,345 @@
+package tapd
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWikiService_CreateWiki(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/tapd_wikis", r.URL.Path)
+
+		var req struct {
+			WorkspaceID         int    `json:"workspace_id"`
+			Name                string `json:"name"`
+			Creator             string `json:"creator"`
+			MarkdownDescription string `json:"markdown_description"`
+			Note                string `json:"note"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, 11112222, req.WorkspaceID)
+		assert.Equal(t, "API 文档", req.Name)
+		assert.Equal(t, "张三", req.Creator)
+		assert.Equal(t, "# API 文档", req.MarkdownDescription)
+		assert.Equal(t, "v1.0.0", req.Note)
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/wiki/create_wiki.json"))
+	}))
+
+	wiki, _, err := client.WikiService.CreateWiki(ctx, &CreateWikiRequest{
+		WorkspaceID:         Ptr(11112222),
+		Name:                Ptr("API 文档"),
+		Creator:             Ptr("张三"),
+		MarkdownDescription: Ptr("# API 文档"),
+		Note:                Ptr("v1.0.0"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "1111112222001000091", wiki.ID)
+	assert.Equal(t, "11112222", wiki.WorkspaceID)
+	assert.Equal(t, "API 文档", wiki.Name)
+	assert.Equal(t, "# API 文档", wiki.MarkdownDescription)
+	assert.Equal(t, "v1.0.0", wiki.Note)
+	assert.Equal(t, "张三", wiki.Creator)
+}
+
+func TestWikiService_GetWikis(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/tapd_wikis", r.URL.Path)
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+		assert.Equal(t, "API 文档", r.URL.Query().Get("name"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/wiki/get_wikis.json"))
+	}))
+
+	wikis, _, err := client.WikiService.GetWikis(ctx, &GetWikisRequest{
+		WorkspaceID: Ptr(11112222),
+		Name:        Ptr("API 文档"),
+	})
+	require.NoError(t, err)
+	require.Len(t, wikis, 2)
+	assert.Equal(t, "1111112222001000091", wikis[0].ID)
+	assert.Equal(t, "12", wikis[0].ViewCount)
+	assert.Equal(t, "1111112222001000092", wikis[1].ID)
+	assert.Equal(t, "API 文档（旧）", wikis[1].Name)
+	assert.Equal(t, "1111112222001000091", wikis[1].ParentWikiID)
+}
+
+func TestWikiService_GetWikisCount(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/tapd_wikis/count", r.URL.Path)
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/wiki/get_wikis_count.json"))
+	}))
+
+	count, _, err := client.WikiService.GetWikisCount(ctx, &GetWikisCountRequest{
+		WorkspaceID: Ptr(11112222),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+}
+
+func TestWikiService_UpdateWiki(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/tapd_wikis", r.URL.Path)
+
+		var req struct {
+			WorkspaceID int    `json:"workspace_id"`
+			ID          int64  `json:"id"`
+			Modifier    string `json:"modifier"`
+			Note        string `json:"note"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, 11112222, req.WorkspaceID)
+		assert.Equal(t, int64(1111112222001000091), req.ID)
+		assert.Equal(t, "李四", req.Modifier)
+		assert.Equal(t, "v1.1.0", req.Note)
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/wiki/update_wiki.json"))
+	}))
+
+	wiki, _, err := client.WikiService.UpdateWiki(ctx, &UpdateWikiRequest{
+		WorkspaceID: Ptr(11112222),
+		ID:          Ptr[int64](1111112222001000091),
+		Modifier:    Ptr("李四"),
+		Note:        Ptr("v1.1.0"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "1111112222001000091", wiki.ID)
+	assert.Equal(t, "李四", wiki.Modifier)
+	assert.Equal(t, "v1.1.0", wiki.Note)
+	assert.Equal(t, "2025-02-20 10:00:00", wiki.Modified)
+}
+
+func TestWikiService_CreateOrUpdateWiki(t *testing.T) {
+	t.Run("update existing wiki", func(t *testing.T) {
+		var updated bool
+		_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/tapd_wikis", r.URL.Path)
+
+			switch r.Method {
+			case http.MethodGet:
+				assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+				assert.Equal(t, "API 文档", r.URL.Query().Get("name"))
+				assert.Equal(t, "id,name", r.URL.Query().Get("fields"))
+				_, _ = w.Write(loadData(t, "internal/testdata/api/wiki/get_wikis.json"))
+			case http.MethodPost:
+				var req map[string]any
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+				assert.Equal(t, float64(1111112222001000091), req["id"])
+				assert.Equal(t, "李四", req["modifier"])
+				assert.NotContains(t, req, "creator")
+				updated = true
+				_, _ = w.Write(loadData(t, "internal/testdata/api/wiki/update_wiki.json"))
+			}
+		}))
+
+		wiki, _, err := client.WikiService.CreateOrUpdateWiki(ctx, &CreateOrUpdateWikiRequest{
+			WorkspaceID:         Ptr(11112222),
+			Name:                Ptr("API 文档"),
+			Operator:            Ptr("李四"),
+			MarkdownDescription: Ptr("# API 文档\n\n## v1.1.0"),
+			Note:                Ptr("v1.1.0"),
+		})
+		require.NoError(t, err)
+		assert.True(t, updated)
+		assert.Equal(t, "1111112222001000091", wiki.ID)
+		assert.Equal(t, "李四", wiki.Modifier)
+	})
+
+	t.Run("create missing wiki", func(t *testing.T) {
+		var created bool
+		_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/tapd_wikis", r.URL.Path)
+
+			switch r.Method {
+			case http.MethodGet:
+				if r.URL.Query().Get("page") != "1" {
+					_, _ = w.Write([]byte(`{"status":1,"data":[],"info":"success"}`))
+					return
+				}
+				// only a fuzzy match comes back, which must not be updated
+				_, _ = w.Write([]byte(`{"status":1,"data":[{"Wiki":{"id":"1111112222001000092","name":"API 文档（旧）"}}],"info":"success"}`))
+			case http.MethodPost:
+				var req map[string]any
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+				assert.NotContains(t, req, "id")
+				assert.Equal(t, "张三", req["creator"])
+				assert.Equal(t, "API 文档", req["name"])
+				created = true
+				_, _ = w.Write(loadData(t, "internal/testdata/api/wiki/create_wiki.json"))
+			}
+		}))
+
+		wiki, _, err := client.WikiService.CreateOrUpdateWiki(ctx, &CreateOrUpdateWikiRequest{
+			WorkspaceID:         Ptr(11112222),
+			Name:                Ptr("API 文档"),
+			Operator:            Ptr("张三"),
+			MarkdownDescription: Ptr("# API 文档"),
+		})
+		require.NoError(t, err)
+		assert.True(t, created)
+		assert.Equal(t, "1111112222001000091", wiki.ID)
+	})
+
+	t.Run("missing name", func(t *testing.T) {
+		var requests int
+		_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests++
+		}))
+
+		_, _, err := client.WikiService.CreateOrUpdateWiki(ctx, nil)
+		assert.Error(t, err)
+
+		_, _, err = client.WikiService.CreateOrUpdateWiki(ctx, &CreateOrUpdateWikiRequest{
+			WorkspaceID: Ptr(11112222),
+			Operator:    Ptr("张三"),
+		})
+		assert.Error(t, err)
+		assert.Zero(t, requests)
+	})
+}
+
+func TestWikiService_GetWikiDrawios(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/tapd_wikis/get_drawio", r.URL.Path)
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+		assert.Equal(t, "1111112222001000091", r.URL.Query().Get("wiki_id"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/wiki/get_wiki_drawios.json"))
+	}))
+
+	drawios, _, err := client.WikiService.GetWikiDrawios(ctx, &GetWikiDrawiosRequest{
+		WorkspaceID: Ptr(11112222),
+		WikiID:      Ptr[int64](1111112222001000091),
+	})
+	require.NoError(t, err)
+	require.Len(t, drawios, 1)
+	assert.Equal(t, "1111112222001000101", drawios[0].ID)
+	assert.Equal(t, "1111112222001000091", drawios[0].WikiID)
+	assert.Equal(t, "架构图", drawios[0].Name)
+	assert.Equal(t, `<mxfile><diagram name="Page-1"></diagram></mxfile>`, drawios[0].Data)
+}
+
+func TestWikiService_GetWikiFollowers(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/tapd_wikis/followers", r.URL.Path)
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+		assert.Equal(t, "1111112222001000091", r.URL.Query().Get("wiki_id"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/wiki/get_wiki_followers.json"))
+	}))
+
+	followers, _, err := client.WikiService.GetWikiFollowers(ctx, &GetWikiFollowersRequest{
+		WorkspaceID: Ptr(11112222),
+		WikiID:      NewMulti[int64](1111112222001000091),
+	})
+	require.NoError(t, err)
+	require.Len(t, followers, 2)
+	assert.Equal(t, "李四", followers[0].Follower)
+	assert.Equal(t, "王五", followers[1].Follower)
+	assert.Equal(t, "1111112222001000091", followers[1].WikiID)
+}
+
+func TestWikiService_GetWikiFollowersCount(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/tapd_wikis/followers/count", r.URL.Path)
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+		assert.Equal(t, "1111112222001000091", r.URL.Query().Get("wiki_id"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/wiki/get_wiki_followers_count.json"))
+	}))
+
+	count, _, err := client.WikiService.GetWikiFollowersCount(ctx, &GetWikiFollowersCountRequest{
+		WorkspaceID: Ptr(11112222),
+		WikiID:      NewMulti[int64](1111112222001000091),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+}
+
+func TestWikiService_GetWikiAccessScope(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/tapd_wikis/view_scope", r.URL.Path)
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+		assert.Equal(t, "1111112222001000091", r.URL.Query().Get("wiki_id"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/wiki/get_wiki_access_scope.json"))
+	}))
+
+	scope, _, err := client.WikiService.GetWikiAccessScope(ctx, &GetWikiAccessScopeRequest{
+		WorkspaceID: Ptr(11112222),
+		WikiID:      Ptr[int64](1111112222001000091),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"张三", "李四"}, scope.Users)
+	require.Len(t, scope.UserGroups, 1)
+	assert.Equal(t, "1000000000000000002", scope.UserGroups[0].ID)
+	assert.Equal(t, "研发组", scope.UserGroups[0].Name)
+}
+
+func TestWikiService_GetWikiTags(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/tapd_wikis/tags", r.URL.Path)
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+		assert.Equal(t, "1111112222001000091", r.URL.Query().Get("wiki_id"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/wiki/get_wiki_tags.json"))
+	}))
+
+	tags, _, err := client.WikiService.GetWikiTags(ctx, &GetWikiTagsRequest{
+		WorkspaceID: Ptr(11112222),
+		WikiID:      NewMulti[int64](1111112222001000091),
+	})
+	require.NoError(t, err)
+	require.Len(t, tags, 1)
+	assert.Equal(t, "1111112222001000121", tags[0].ID)
+	assert.Equal(t, "api", tags[0].Name)
+}
+
+func TestWikiService_GetWikiTagsCount(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/tapd_wikis/tags/count", r.URL.Path)
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/wiki/get_wiki_tags_count.json"))
+	}))
+
+	count, _, err := client.WikiService.GetWikiTagsCount(ctx, &GetWikiTagsCountRequest{
+		WorkspaceID: Ptr(11112222),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+}
+
+func TestWikiService_GetWikiAttachmentsCount(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/tapd_wikis/attachments/count", r.URL.Path)
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+		assert.Equal(t, "1111112222001000091", r.URL.Query().Get("wiki_id"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/wiki/get_wiki_attachments_count.json"))
+	}))
+
+	count, _, err := client.WikiService.GetWikiAttachmentsCount(ctx, &GetWikiAttachmentsCountRequest{
+		WorkspaceID: Ptr(11112222),
+		WikiID:      NewMulti[int64](1111112222001000091),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+}
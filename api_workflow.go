@@ -2,7 +2,9 @@ package tapd
 
 import (
 	"context"
+	"maps"
 	"net/http"
+	"slices"
 )
 
 // WorkflowService 工作流
@@ -10,8 +12,109 @@ type WorkflowService struct {
 	client *Client
 }
 
+// WorkflowStatus 工作流状态，Alias 为英文名，Name 为中文名
+type WorkflowStatus = WorkflowAllLastStepStatus
+
+// WorkflowTransition 工作流流转细则
+type WorkflowTransition struct {
+	Name         string                     `json:"Name,omitempty"`         // 流转名称，如 planning-developing
+	StepPrevious string                     `json:"StepPrevious,omitempty"` // 流转前状态
+	StepNext     string                     `json:"StepNext,omitempty"`     // 流转后状态
+	AppendFields []*WorkflowTransitionField `json:"Appendfield,omitempty"`  // 流转时需要填写的字段
+}
+
+// WorkflowTransitionField 工作流流转时需要填写的字段
+type WorkflowTransitionField struct {
+	DBModel   string `json:"DBModel,omitempty"`   // 业务对象模型，如 Story
+	FieldName string `json:"FieldName,omitempty"` // 字段名
+	Notnull   string `json:"Notnull,omitempty"`   // 是否必填，1 为必填
+	Sort      string `json:"Sort,omitempty"`      // 排序
+}
+
+// WorkflowInfo 工作流
+type WorkflowInfo struct {
+	ID          string `json:"id,omitempty"`           // 工作流ID
+	WorkspaceID string `json:"workspace_id,omitempty"` // 项目ID
+	Name        string `json:"name,omitempty"`         // 工作流名称
+	Description string `json:"description,omitempty"`  // 描述
+	System      string `json:"system,omitempty"`       // 系统名，如 story、bug
+	IsDefault   string `json:"is_default,omitempty"`   // 是否默认工作流
+	Creator     string `json:"creator,omitempty"`      // 创建人
+	Created     string `json:"created,omitempty"`      // 创建时间
+	Modified    string `json:"modified,omitempty"`     // 最后修改时间
+}
+
+// -----------------------------------------------------------------------------
 // 获取工作流流转细则
+// -----------------------------------------------------------------------------
+
+type GetTransitionsRequest struct {
+	WorkspaceID    *int64  `url:"workspace_id,omitempty"`     // [必须]项目ID
+	System         *string `url:"system,omitempty"`           // [必须]系统名，可选值：story,bug
+	WorkitemTypeID *int64  `url:"workitem_type_id,omitempty"` // 需求类别ID，system 为 story 时必须
+}
+
+// GetTransitions 获取工作流流转细则
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/workflow/get_workflow_transitions.html
+func (s *WorkflowService) GetTransitions(
+	ctx context.Context, request *GetTransitionsRequest, opts ...RequestOption,
+) ([]*WorkflowTransition, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "workflows/transitions", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var transitions []*WorkflowTransition
+	resp, err := s.client.Do(req, &transitions)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return transitions, resp, nil
+}
+
+// GetWorkflow 获取工作流流转细则，并构造可在本地校验状态流转的 Workflow
+func (s *WorkflowService) GetWorkflow(
+	ctx context.Context, request *GetTransitionsRequest, opts ...RequestOption,
+) (*Workflow, *Response, error) {
+	transitions, resp, err := s.GetTransitions(ctx, request, opts...)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return NewWorkflow(transitions), resp, nil
+}
+
+// -----------------------------------------------------------------------------
 // 获取工作流结束状态
+// -----------------------------------------------------------------------------
+
+type GetLastStepsRequest struct {
+	WorkspaceID    *int64  `url:"workspace_id,omitempty"`     // [必须]项目ID
+	System         *string `url:"system,omitempty"`           // [必须]系统名，可选值：story,bug
+	WorkitemTypeID *int64  `url:"workitem_type_id,omitempty"` // 需求类别ID，system 为 story 时必须
+}
+
+// GetLastSteps 获取工作流结束状态
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/workflow/get_workflow_last_steps.html
+func (s *WorkflowService) GetLastSteps(
+	ctx context.Context, request *GetLastStepsRequest, opts ...RequestOption,
+) ([]*WorkflowStatus, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "workflows/last_steps", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result map[string]string
+	resp, err := s.client.Do(req, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return convertWorkflowStatuses(result), resp, nil
+}
 
 // GetAllLastSteps 获取所有结束状态
 //
@@ -31,12 +134,8 @@ func (s *WorkflowService) GetAllLastSteps(
 	}
 
 	steps := make([]*WorkflowAllLastStep, 0, len(result))
-	for key, value := range result {
-		statuses := make([]*WorkflowAllLastStepStatus, 0, len(value))
-		for alias, name := range value {
-			statuses = append(statuses, &WorkflowAllLastStepStatus{Alias: alias, Name: name})
-		}
-		steps = append(steps, &WorkflowAllLastStep{Key: key, Status: statuses})
+	for _, key := range slices.Sorted(maps.Keys(result)) {
+		steps = append(steps, &WorkflowAllLastStep{Key: key, Status: convertWorkflowStatuses(result[key])})
 	}
 
 	return steps, resp, nil
@@ -58,6 +157,107 @@ type WorkflowAllLastStepStatus struct {
 	Name  string `json:"name,omitempty"`  // 状态名称
 }
 
+// -----------------------------------------------------------------------------
 // 获取工作流状态中英文名对应关系
+// -----------------------------------------------------------------------------
+
+type GetStatusMapRequest struct {
+	WorkspaceID    *int64  `url:"workspace_id,omitempty"`     // [必须]项目ID
+	System         *string `url:"system,omitempty"`           // [必须]系统名，可选值：story,bug
+	WorkitemTypeID *int64  `url:"workitem_type_id,omitempty"` // 需求类别ID
+}
+
+// GetStatusMap 获取工作流状态中英文名对应关系，返回状态别名（英文名）到状态名称（中文名）的映射
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/workflow/get_workflow_status_map.html
+func (s *WorkflowService) GetStatusMap(
+	ctx context.Context, request *GetStatusMapRequest, opts ...RequestOption,
+) (map[string]string, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "workflows/status_map", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var statusMap map[string]string
+	resp, err := s.client.Do(req, &statusMap)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return statusMap, resp, nil
+}
+
+// -----------------------------------------------------------------------------
 // 获取工作流起始状态
+// -----------------------------------------------------------------------------
+
+type GetFirstStepsRequest struct {
+	WorkspaceID    *int64  `url:"workspace_id,omitempty"`     // [必须]项目ID
+	System         *string `url:"system,omitempty"`           // [必须]系统名，可选值：story,bug
+	WorkitemTypeID *int64  `url:"workitem_type_id,omitempty"` // 需求类别ID，system 为 story 时必须
+}
+
+// GetFirstSteps 获取工作流起始状态
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/workflow/get_workflow_first_steps.html
+func (s *WorkflowService) GetFirstSteps(
+	ctx context.Context, request *GetFirstStepsRequest, opts ...RequestOption,
+) ([]*WorkflowStatus, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "workflows/first_steps", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result map[string]string
+	resp, err := s.client.Do(req, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return convertWorkflowStatuses(result), resp, nil
+}
+
+// -----------------------------------------------------------------------------
 // 获取项目下的工作流列表
+// -----------------------------------------------------------------------------
+
+type GetWorkflowsRequest struct {
+	WorkspaceID *int64  `url:"workspace_id,omitempty"` // [必须]项目ID
+	System      *string `url:"system,omitempty"`       // 系统名，可选值：story,bug
+}
+
+// GetWorkflows 获取项目下的工作流列表
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/workflow/get_workflow_list.html
+func (s *WorkflowService) GetWorkflows(
+	ctx context.Context, request *GetWorkflowsRequest, opts ...RequestOption,
+) ([]*WorkflowInfo, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "workflows/workflow_list", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var items []struct {
+		Workflow *WorkflowInfo `json:"Workflow"`
+	}
+	resp, err := s.client.Do(req, &items)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	workflows := make([]*WorkflowInfo, 0, len(items))
+	for _, item := range items {
+		workflows = append(workflows, item.Workflow)
+	}
+
+	return workflows, resp, nil
+}
+
+// convertWorkflowStatuses converts an alias => name map to statuses sorted by alias.
+func convertWorkflowStatuses(result map[string]string) []*WorkflowStatus {
+	statuses := make([]*WorkflowStatus, 0, len(result))
+	for _, alias := range slices.Sorted(maps.Keys(result)) {
+		statuses = append(statuses, &WorkflowStatus{Alias: alias, Name: result[alias]})
+	}
+	return statuses
+}
@@ -19,9 +19,150 @@ func TestWorkflowService_GetAllLastSteps(t *testing.T) {
 	}))
 
 	steps, _, err := client.WorkflowService.GetAllLastSteps(ctx, &GetAllLastStepsRequest{
-		WorkspaceID: Ptr(11112222),
+		WorkspaceID: Ptr[int64](11112222),
 		System:      Ptr("story"),
 	})
 	assert.NoError(t, err)
 	require.Len(t, steps, 2)
+
+	// sorted by key and alias
+	assert.Equal(t, "1112222991001000013", steps[0].Key)
+	assert.Equal(t, []*WorkflowStatus{
+		{Alias: "rejected", Name: "已拒绝"},
+		{Alias: "status_2", Name: "已上线"},
+		{Alias: "status_3", Name: "已验收"},
+	}, steps[0].Status)
+	assert.Equal(t, "1112222991001000137", steps[1].Key)
+	assert.Equal(t, []*WorkflowStatus{
+		{Alias: "status_10", Name: "已完成"},
+		{Alias: "status_11", Name: "已取消"},
+	}, steps[1].Status)
+}
+
+func TestWorkflowService_GetTransitions(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/workflows/transitions", r.URL.Path)
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+		assert.Equal(t, "story", r.URL.Query().Get("system"))
+		assert.Equal(t, "1111112222001000013", r.URL.Query().Get("workitem_type_id"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/workflow/get_transitions.json"))
+	}))
+
+	transitions, _, err := client.WorkflowService.GetTransitions(ctx, &GetTransitionsRequest{
+		WorkspaceID:    Ptr[int64](11112222),
+		System:         Ptr("story"),
+		WorkitemTypeID: Ptr[int64](1111112222001000013),
+	})
+	require.NoError(t, err)
+	require.Len(t, transitions, 6)
+	assert.Equal(t, "planning-developing", transitions[0].Name)
+	assert.Equal(t, "planning", transitions[0].StepPrevious)
+	assert.Equal(t, "developing", transitions[0].StepNext)
+	require.Len(t, transitions[0].AppendFields, 2)
+	assert.Equal(t, "Story", transitions[0].AppendFields[0].DBModel)
+	assert.Equal(t, "owner", transitions[0].AppendFields[0].FieldName)
+	assert.Equal(t, "1", transitions[0].AppendFields[0].Notnull)
+	assert.Empty(t, transitions[3].AppendFields)
+}
+
+func TestWorkflowService_GetWorkflow(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/workflows/transitions", r.URL.Path)
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/workflow/get_transitions.json"))
+	}))
+
+	workflow, _, err := client.WorkflowService.GetWorkflow(ctx, &GetTransitionsRequest{
+		WorkspaceID:    Ptr[int64](11112222),
+		System:         Ptr("story"),
+		WorkitemTypeID: Ptr[int64](1111112222001000013),
+	})
+	require.NoError(t, err)
+	assert.True(t, workflow.CanTransition("planning", "developing"))
+	assert.False(t, workflow.CanTransition("planning", "resolved"))
+	assert.Equal(t, []string{"developing", "rejected"}, workflow.NextStatuses("planning"))
+}
+
+func TestWorkflowService_GetLastSteps(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/workflows/last_steps", r.URL.Path)
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+		assert.Equal(t, "story", r.URL.Query().Get("system"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/workflow/get_last_steps.json"))
+	}))
+
+	steps, _, err := client.WorkflowService.GetLastSteps(ctx, &GetLastStepsRequest{
+		WorkspaceID:    Ptr[int64](11112222),
+		System:         Ptr("story"),
+		WorkitemTypeID: Ptr[int64](1111112222001000013),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []*WorkflowStatus{
+		{Alias: "rejected", Name: "已拒绝"},
+		{Alias: "resolved", Name: "已实现"},
+	}, steps)
+}
+
+func TestWorkflowService_GetStatusMap(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/workflows/status_map", r.URL.Path)
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+		assert.Equal(t, "story", r.URL.Query().Get("system"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/workflow/get_status_map.json"))
+	}))
+
+	statusMap, _, err := client.WorkflowService.GetStatusMap(ctx, &GetStatusMapRequest{
+		WorkspaceID: Ptr[int64](11112222),
+		System:      Ptr("story"),
+	})
+	require.NoError(t, err)
+	assert.Len(t, statusMap, 5)
+	assert.Equal(t, "规划中", statusMap["planning"])
+	assert.Equal(t, "已实现", statusMap["resolved"])
+}
+
+func TestWorkflowService_GetFirstSteps(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/workflows/first_steps", r.URL.Path)
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+		assert.Equal(t, "story", r.URL.Query().Get("system"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/workflow/get_first_steps.json"))
+	}))
+
+	steps, _, err := client.WorkflowService.GetFirstSteps(ctx, &GetFirstStepsRequest{
+		WorkspaceID:    Ptr[int64](11112222),
+		System:         Ptr("story"),
+		WorkitemTypeID: Ptr[int64](1111112222001000013),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []*WorkflowStatus{{Alias: "planning", Name: "规划中"}}, steps)
+}
+
+func TestWorkflowService_GetWorkflows(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/workflows/workflow_list", r.URL.Path)
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/workflow/get_workflows.json"))
+	}))
+
+	workflows, _, err := client.WorkflowService.GetWorkflows(ctx, &GetWorkflowsRequest{
+		WorkspaceID: Ptr[int64](11112222),
+	})
+	require.NoError(t, err)
+	require.Len(t, workflows, 2)
+	assert.Equal(t, "1111112222001000211", workflows[0].ID)
+	assert.Equal(t, "需求默认工作流", workflows[0].Name)
+	assert.Equal(t, "story", workflows[0].System)
+	assert.Equal(t, "bug", workflows[1].System)
 }
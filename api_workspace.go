@@ -9,18 +9,6 @@ type WorkspaceService struct {
 	client *Client
 }
 
-// 获取子项目信息
-// 获取项目信息
-// 获取指定项目成员
-// 添加项目成员
-// 获取公司项目列表
-// 获取用户组ID对照关系
-// 获取用户参与的项目列表
-// 获取项目成员列表
-// 获取项目自定义字段
-// 更新项目信息
-// 获取项目文档
-
 type GetMemberActivityLogRequest struct {
 	// [必须]项目 id 为公司id则查询所有项目
 	WorkspaceID *int `url:"workspace_id,omitempty"`
@@ -140,9 +128,9 @@ type WorkspaceMember struct {
 	Data UserWorkspace `json:"UserWorkspace,omitempty"`
 }
 
-// GetMemberActivityLog 获取成员活动日志
+// GetMembers 获取项目成员列表
 //
-// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/workspace/member_activity_log.html
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/workspace/users.html
 func (s *WorkspaceService) GetMembers(
 	ctx context.Context, request *GetMembersRequest, opts ...RequestOption,
 ) ([]*WorkspaceMember, *Response, error) {
@@ -172,6 +160,10 @@ type WorkspaceInfo struct {
 	Category    string `json:"category,omitempty"`
 	Status      string `json:"status,omitempty"`
 	Description string `json:"description,omitempty"`
+	BeginDate   string `json:"begin_date,omitempty"`
+	EndDate     string `json:"end_date,omitempty"`
+	ExternalOn  string `json:"external_on,omitempty"`
+	ParentID    string `json:"parent_id,omitempty"`
 	Created     string `json:"created,omitempty"`
 	Creator     string `json:"creator,omitempty"`
 }
@@ -199,3 +191,321 @@ func (s *WorkspaceService) GetWorkspaceInfo(
 
 	return &response.Data, resp, nil
 }
+
+// WorkspaceDocument 项目文档
+type WorkspaceDocument struct {
+	ID          string `json:"id,omitempty"`           // ID
+	WorkspaceID string `json:"workspace_id,omitempty"` // 项目ID
+	Name        string `json:"name,omitempty"`         // 文档名称
+	Type        string `json:"type,omitempty"`         // 文档类型
+	FolderID    string `json:"folder_id,omitempty"`    // 所属文件夹ID
+	Description string `json:"description,omitempty"`  // 文档说明
+	Creator     string `json:"creator,omitempty"`      // 创建人
+	Modifier    string `json:"modifier,omitempty"`     // 最后修改人
+	Created     string `json:"created,omitempty"`      // 创建时间
+	Modified    string `json:"modified,omitempty"`     // 最后修改时间
+}
+
+// -----------------------------------------------------------------------------
+// 获取子项目信息
+// -----------------------------------------------------------------------------
+
+type GetSubWorkspacesRequest struct {
+	WorkspaceID *int `url:"workspace_id,omitempty"` // [必须]项目集或公司ID
+}
+
+// GetSubWorkspaces 获取子项目信息
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/workspace/get_sub_workspaces.html
+func (s *WorkspaceService) GetSubWorkspaces(
+	ctx context.Context, request *GetSubWorkspacesRequest, opts ...RequestOption,
+) ([]*WorkspaceInfo, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "workspaces/sub_workspaces", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var items []struct {
+		Workspace *WorkspaceInfo `json:"Workspace"`
+	}
+	resp, err := s.client.Do(req, &items)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	workspaces := make([]*WorkspaceInfo, 0, len(items))
+	for _, item := range items {
+		workspaces = append(workspaces, item.Workspace)
+	}
+
+	return workspaces, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 获取指定项目成员
+// -----------------------------------------------------------------------------
+
+type GetMemberRequest struct {
+	WorkspaceID *int    `url:"workspace_id,omitempty"` // [必须]项目ID
+	User        *string `url:"user,omitempty"`         // [必须]用户昵称
+}
+
+// GetMember 获取指定项目成员
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/workspace/get_member_info.html
+func (s *WorkspaceService) GetMember(
+	ctx context.Context, request *GetMemberRequest, opts ...RequestOption,
+) (*UserWorkspace, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "workspaces/get_member_info", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var item struct {
+		UserWorkspace *UserWorkspace `json:"UserWorkspace"`
+	}
+	resp, err := s.client.Do(req, &item)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return item.UserWorkspace, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 添加项目成员
+// -----------------------------------------------------------------------------
+
+type AddMemberRequest struct {
+	WorkspaceID *int          `json:"workspace_id,omitempty"` // [必须]项目ID
+	Nick        *string       `json:"nick,omitempty"`         // [必须]用户昵称
+	RoleID      *Multi[int64] `json:"role_id,omitempty"`      // 用户组ID，多个以','逗号隔开，见 GetUserGroups
+	Creator     *string       `json:"creator,omitempty"`      // 操作人
+}
+
+// AddMember 添加项目成员
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/workspace/add_member_by_nick.html
+func (s *WorkspaceService) AddMember(
+	ctx context.Context, request *AddMemberRequest, opts ...RequestOption,
+) (*UserWorkspace, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "workspaces/add_member_by_nick", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var item struct {
+		UserWorkspace *UserWorkspace `json:"UserWorkspace"`
+	}
+	resp, err := s.client.Do(req, &item)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return item.UserWorkspace, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 获取公司项目列表
+// -----------------------------------------------------------------------------
+
+type GetCompanyWorkspacesRequest struct {
+	CompanyID *int `url:"company_id,omitempty"` // [必须]公司ID
+	Category  *int `url:"category,omitempty"`   // 项目类型
+}
+
+// GetCompanyWorkspaces 获取公司项目列表
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/workspace/projects.html
+func (s *WorkspaceService) GetCompanyWorkspaces(
+	ctx context.Context, request *GetCompanyWorkspacesRequest, opts ...RequestOption,
+) ([]*WorkspaceInfo, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "workspaces/projects", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var items []struct {
+		Workspace *WorkspaceInfo `json:"Workspace"`
+	}
+	resp, err := s.client.Do(req, &items)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	workspaces := make([]*WorkspaceInfo, 0, len(items))
+	for _, item := range items {
+		workspaces = append(workspaces, item.Workspace)
+	}
+
+	return workspaces, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 获取用户组ID对照关系
+// -----------------------------------------------------------------------------
+
+// GetUserGroups 获取用户组ID对照关系
+//
+// TAPD 中的用户组即角色，与 UserService.GetRoles 为同一接口。
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/workspace/get_roles.html
+func (s *WorkspaceService) GetUserGroups(
+	ctx context.Context, request *GetRolesRequest, opts ...RequestOption,
+) ([]*UserRole, *Response, error) {
+	return s.client.UserService.GetRoles(ctx, request, opts...)
+}
+
+// -----------------------------------------------------------------------------
+// 获取用户参与的项目列表
+// -----------------------------------------------------------------------------
+
+type GetUserWorkspacesRequest struct {
+	Nick      *string `url:"nick,omitempty"`       // [必须]用户昵称
+	CompanyID *int    `url:"company_id,omitempty"` // 公司ID
+}
+
+// GetUserWorkspaces 获取用户参与的项目列表
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/workspace/user_participant_projects.html
+func (s *WorkspaceService) GetUserWorkspaces(
+	ctx context.Context, request *GetUserWorkspacesRequest, opts ...RequestOption,
+) ([]*WorkspaceInfo, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "workspaces/user_participant_projects", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var items []struct {
+		Workspace *WorkspaceInfo `json:"Workspace"`
+	}
+	resp, err := s.client.Do(req, &items)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	workspaces := make([]*WorkspaceInfo, 0, len(items))
+	for _, item := range items {
+		workspaces = append(workspaces, item.Workspace)
+	}
+
+	return workspaces, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 获取项目自定义字段
+// -----------------------------------------------------------------------------
+
+type GetWorkspaceCustomFieldsSettingsRequest struct {
+	WorkspaceID *int `url:"workspace_id,omitempty"` // [必须]项目ID
+}
+
+// GetWorkspaceCustomFieldsSettings 获取项目自定义字段
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/workspace/get_workspace_custom_fields.html
+func (s *WorkspaceService) GetWorkspaceCustomFieldsSettings(
+	ctx context.Context, request *GetWorkspaceCustomFieldsSettingsRequest, opts ...RequestOption,
+) ([]*CustomFieldsSetting, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "workspaces/custom_fields_settings", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var items []struct {
+		CustomFieldConfig *CustomFieldsSetting `json:"CustomFieldConfig"`
+	}
+	resp, err := s.client.Do(req, &items)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	settings := make([]*CustomFieldsSetting, 0, len(items))
+	for _, item := range items {
+		settings = append(settings, item.CustomFieldConfig)
+	}
+
+	return settings, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 更新项目信息
+// -----------------------------------------------------------------------------
+
+type UpdateWorkspaceInfoRequest struct {
+	WorkspaceID *int    `json:"workspace_id,omitempty"` // [必须]项目ID
+	Name        *string `json:"name,omitempty"`         // 项目名称
+	PrettyName  *string `json:"pretty_name,omitempty"`  // 项目英文名
+	Description *string `json:"description,omitempty"`  // 项目描述
+	Status      *string `json:"status,omitempty"`       // 项目状态
+	BeginDate   *string `json:"begin_date,omitempty"`   // 开始时间
+	EndDate     *string `json:"end_date,omitempty"`     // 结束时间
+	Operator    *string `json:"operator,omitempty"`     // 操作人
+}
+
+// UpdateWorkspaceInfo 更新项目信息
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/workspace/update_workspace.html
+func (s *WorkspaceService) UpdateWorkspaceInfo(
+	ctx context.Context, request *UpdateWorkspaceInfoRequest, opts ...RequestOption,
+) (*WorkspaceInfo, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "workspaces/update_workspace", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var item struct {
+		Workspace *WorkspaceInfo `json:"Workspace"`
+	}
+	resp, err := s.client.Do(req, &item)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return item.Workspace, resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// 获取项目文档
+// -----------------------------------------------------------------------------
+
+type GetWorkspaceDocumentsRequest struct {
+	WorkspaceID *int           `url:"workspace_id,omitempty"` // [必须]项目ID
+	ID          *Multi[int64]  `url:"id,omitempty"`           // ID	支持多ID查询
+	Name        *string        `url:"name,omitempty"`         // 文档名称	支持模糊匹配
+	FolderID    *int64         `url:"folder_id,omitempty"`    // 所属文件夹ID
+	Creator     *string        `url:"creator,omitempty"`      // 创建人
+	Modifier    *string        `url:"modifier,omitempty"`     // 最后修改人
+	Created     *TimeQuery     `url:"created,omitempty"`      // 创建时间	支持时间查询
+	Modified    *TimeQuery     `url:"modified,omitempty"`     // 最后修改时间	支持时间查询
+	Limit       *int           `url:"limit,omitempty"`        // 设置返回数量限制，默认为30
+	Page        *int           `url:"page,omitempty"`         // 返回当前数量限制下第N页的数据，默认为1（第一页）
+	Order       *Order         `url:"order,omitempty"`        // 排序规则，规则：字段名 ASC或者DESC
+	Fields      *Multi[string] `url:"fields,omitempty"`       // 设置获取的字段，多个字段间以','逗号隔开
+}
+
+// GetWorkspaceDocuments 获取项目文档
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/workspace/get_documents.html
+func (s *WorkspaceService) GetWorkspaceDocuments(
+	ctx context.Context, request *GetWorkspaceDocumentsRequest, opts ...RequestOption,
+) ([]*WorkspaceDocument, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "documents", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var items []struct {
+		Document *WorkspaceDocument `json:"Document"`
+	}
+	resp, err := s.client.Do(req, &items)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	documents := make([]*WorkspaceDocument, 0, len(items))
+	for _, item := range items {
+		documents = append(documents, item.Document)
+	}
+
+	return documents, resp, nil
+}
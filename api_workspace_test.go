@@ -0,0 +1,209 @@
+package tapd
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkspaceService_GetSubWorkspaces(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/workspaces/sub_workspaces", r.URL.Path)
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/workspace/get_sub_workspaces.json"))
+	}))
+
+	workspaces, _, err := client.WorkspaceService.GetSubWorkspaces(ctx, &GetSubWorkspacesRequest{
+		WorkspaceID: Ptr(11112222),
+	})
+	require.NoError(t, err)
+	require.Len(t, workspaces, 2)
+	assert.Equal(t, "11112223", workspaces[0].Id)
+	assert.Equal(t, "订单子项目", workspaces[0].Name)
+	assert.Equal(t, "11112222", workspaces[0].ParentID)
+	assert.Equal(t, "11112224", workspaces[1].Id)
+}
+
+func TestWorkspaceService_GetMember(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/workspaces/get_member_info", r.URL.Path)
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+		assert.Equal(t, "王五", r.URL.Query().Get("user"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/workspace/get_member.json"))
+	}))
+
+	member, _, err := client.WorkspaceService.GetMember(ctx, &GetMemberRequest{
+		WorkspaceID: Ptr(11112222),
+		User:        Ptr("王五"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "王五", member.User)
+	assert.Equal(t, []string{"1000000000000000002"}, member.RoleId)
+	assert.Equal(t, "wangwu@example.com", member.Email)
+	assert.Equal(t, "2025-03-10", member.JoinProjectTime)
+}
+
+func TestWorkspaceService_AddMember(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/workspaces/add_member_by_nick", r.URL.Path)
+
+		var req struct {
+			WorkspaceID int    `json:"workspace_id"`
+			Nick        string `json:"nick"`
+			RoleID      string `json:"role_id"`
+			Creator     string `json:"creator"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, 11112222, req.WorkspaceID)
+		assert.Equal(t, "王五", req.Nick)
+		assert.Equal(t, "1000000000000000002,1000000000000000003", req.RoleID)
+		assert.Equal(t, "onboarding-bot", req.Creator)
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/workspace/add_member.json"))
+	}))
+
+	member, _, err := client.WorkspaceService.AddMember(ctx, &AddMemberRequest{
+		WorkspaceID: Ptr(11112222),
+		Nick:        Ptr("王五"),
+		RoleID:      NewMulti[int64](1000000000000000002, 1000000000000000003),
+		Creator:     Ptr("onboarding-bot"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "王五", member.User)
+	assert.Equal(t, "1", member.Status)
+}
+
+func TestWorkspaceService_GetCompanyWorkspaces(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/workspaces/projects", r.URL.Path)
+		assert.Equal(t, "1000001", r.URL.Query().Get("company_id"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/workspace/get_company_workspaces.json"))
+	}))
+
+	workspaces, _, err := client.WorkspaceService.GetCompanyWorkspaces(ctx, &GetCompanyWorkspacesRequest{
+		CompanyID: Ptr(1000001),
+	})
+	require.NoError(t, err)
+	require.Len(t, workspaces, 3)
+	assert.Equal(t, "11112222", workspaces[0].Id)
+	assert.Equal(t, "order", workspaces[0].PrettyName)
+	assert.Equal(t, "运维平台", workspaces[1].Name)
+	assert.Equal(t, "closed", workspaces[2].Status)
+}
+
+func TestWorkspaceService_GetUserGroups(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/roles", r.URL.Path)
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/workspace/get_user_groups.json"))
+	}))
+
+	groups, _, err := client.WorkspaceService.GetUserGroups(ctx, &GetRolesRequest{
+		WorkspaceID: Ptr(11112222),
+	})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []*UserRole{
+		{ID: "1000000000000000002", Name: "管理员"},
+		{ID: "1000000000000000003", Name: "开发人员"},
+	}, groups)
+}
+
+func TestWorkspaceService_GetUserWorkspaces(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/workspaces/user_participant_projects", r.URL.Path)
+		assert.Equal(t, "王五", r.URL.Query().Get("nick"))
+		assert.Equal(t, "1000001", r.URL.Query().Get("company_id"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/workspace/get_user_workspaces.json"))
+	}))
+
+	workspaces, _, err := client.WorkspaceService.GetUserWorkspaces(ctx, &GetUserWorkspacesRequest{
+		Nick:      Ptr("王五"),
+		CompanyID: Ptr(1000001),
+	})
+	require.NoError(t, err)
+	require.Len(t, workspaces, 1)
+	assert.Equal(t, "11112222", workspaces[0].Id)
+	assert.Equal(t, "订单中心", workspaces[0].Name)
+}
+
+func TestWorkspaceService_GetWorkspaceCustomFieldsSettings(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/workspaces/custom_fields_settings", r.URL.Path)
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/workspace/get_workspace_custom_fields_settings.json"))
+	}))
+
+	settings, _, err := client.WorkspaceService.GetWorkspaceCustomFieldsSettings(ctx, &GetWorkspaceCustomFieldsSettingsRequest{
+		WorkspaceID: Ptr(11112222),
+	})
+	require.NoError(t, err)
+	require.Len(t, settings, 1)
+	assert.Equal(t, "1111112222001000191", settings[0].ID)
+	assert.Equal(t, "workspace", settings[0].EntryType)
+	assert.Equal(t, "custom_field_1", settings[0].CustomField)
+	assert.Equal(t, "项目经理", settings[0].Name)
+	assert.Nil(t, settings[0].Options)
+}
+
+func TestWorkspaceService_UpdateWorkspaceInfo(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/workspaces/update_workspace", r.URL.Path)
+
+		var req struct {
+			WorkspaceID int    `json:"workspace_id"`
+			Description string `json:"description"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, 11112222, req.WorkspaceID)
+		assert.Equal(t, "订单中心 2025 年迭代", req.Description)
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/workspace/update_workspace_info.json"))
+	}))
+
+	workspace, _, err := client.WorkspaceService.UpdateWorkspaceInfo(ctx, &UpdateWorkspaceInfoRequest{
+		WorkspaceID: Ptr(11112222),
+		Description: Ptr("订单中心 2025 年迭代"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "11112222", workspace.Id)
+	assert.Equal(t, "订单中心 2025 年迭代", workspace.Description)
+	assert.Equal(t, "2025-12-31", workspace.EndDate)
+}
+
+func TestWorkspaceService_GetWorkspaceDocuments(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/documents", r.URL.Path)
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+		assert.Equal(t, "1111112222001000200", r.URL.Query().Get("folder_id"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/workspace/get_workspace_documents.json"))
+	}))
+
+	documents, _, err := client.WorkspaceService.GetWorkspaceDocuments(ctx, &GetWorkspaceDocumentsRequest{
+		WorkspaceID: Ptr(11112222),
+		FolderID:    Ptr[int64](1111112222001000200),
+	})
+	require.NoError(t, err)
+	require.Len(t, documents, 1)
+	assert.Equal(t, "1111112222001000201", documents[0].ID)
+	assert.Equal(t, "新人入职指南.docx", documents[0].Name)
+	assert.Equal(t, "李四", documents[0].Modifier)
+}
@@ -0,0 +1,261 @@
+package tapd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// defaultOAuth2ExpiryDelta is how long before expiry an OAuth2 token is refreshed.
+const defaultOAuth2ExpiryDelta = 5 * time.Minute
+
+// Authenticator authenticates API requests.
+type Authenticator interface {
+	// Authenticate adds the credentials to the request.
+	Authenticate(req *http.Request) error
+}
+
+// -----------------------------------------------------------------------------
+// BasicAuthenticator
+// -----------------------------------------------------------------------------
+
+// BasicAuthenticator authenticates requests with the clientID and clientSecret
+// of a TAPD application using HTTP basic authentication.
+type BasicAuthenticator struct {
+	clientID, clientSecret string
+}
+
+var _ Authenticator = (*BasicAuthenticator)(nil)
+
+// NewBasicAuthenticator returns a new basic authenticator.
+func NewBasicAuthenticator(clientID, clientSecret string) *BasicAuthenticator {
+	return &BasicAuthenticator{clientID: clientID, clientSecret: clientSecret}
+}
+
+func (a *BasicAuthenticator) Authenticate(req *http.Request) error {
+	if a.clientID != "" && a.clientSecret != "" {
+		req.SetBasicAuth(a.clientID, a.clientSecret)
+	}
+	return nil
+}
+
+// -----------------------------------------------------------------------------
+// BearerAuthenticator
+// -----------------------------------------------------------------------------
+
+// BearerAuthenticator authenticates requests with a static bearer token, such
+// as a TAPD personal access token.
+type BearerAuthenticator struct {
+	token string
+}
+
+var _ Authenticator = (*BearerAuthenticator)(nil)
+
+// NewBearerAuthenticator returns a new bearer token authenticator.
+func NewBearerAuthenticator(token string) *BearerAuthenticator {
+	return &BearerAuthenticator{token: token}
+}
+
+func (a *BearerAuthenticator) Authenticate(req *http.Request) error {
+	if a.token != "" {
+		req.Header.Set("Authorization", "Bearer "+a.token)
+	}
+	return nil
+}
+
+// -----------------------------------------------------------------------------
+// OAuth2Authenticator
+// -----------------------------------------------------------------------------
+
+// OAuth2Token is an access token issued by the TAPD token endpoint.
+type OAuth2Token struct {
+	AccessToken string `json:"access_token,omitempty"` // 访问令牌
+	ExpiresIn   int    `json:"expires_in,omitempty"`   // 有效期，单位：秒
+	TokenType   string `json:"token_type,omitempty"`   // 令牌类型，固定为 Bearer
+	Scope       string `json:"scope,omitempty"`        // 授权范围
+
+	expiry time.Time
+}
+
+// OAuth2Authenticator authenticates requests with an access token obtained by
+// the client credentials grant of a TAPD OAuth application. The token is cached
+// and refreshed automatically shortly before it expires.
+//
+// An authenticator may be shared by several clients. Unless set explicitly,
+// the token endpoint and the HTTP client are taken from the client sending the
+// request, and tokens are cached per token endpoint.
+type OAuth2Authenticator struct {
+	clientID, clientSecret string
+	tokenURL               string
+	httpClient             *http.Client
+	expiryDelta            time.Duration
+	now                    func() time.Time
+
+	mu         sync.Mutex
+	tokens     map[string]*OAuth2Token  // by token endpoint
+	refreshing map[string]chan struct{} // closed when the token request in flight ends
+}
+
+var _ Authenticator = (*OAuth2Authenticator)(nil)
+
+// OAuth2Option configures an OAuth2Authenticator.
+type OAuth2Option func(*OAuth2Authenticator)
+
+// WithOAuth2TokenURL sets the token endpoint, default https://api.tapd.cn/tokens/request_token
+func WithOAuth2TokenURL(tokenURL string) OAuth2Option {
+	return func(a *OAuth2Authenticator) {
+		a.tokenURL = tokenURL
+	}
+}
+
+// WithOAuth2HTTPClient sets the HTTP client used to request tokens.
+func WithOAuth2HTTPClient(httpClient *http.Client) OAuth2Option {
+	return func(a *OAuth2Authenticator) {
+		a.httpClient = httpClient
+	}
+}
+
+// WithOAuth2ExpiryDelta sets how long before expiry the token is refreshed.
+func WithOAuth2ExpiryDelta(delta time.Duration) OAuth2Option {
+	return func(a *OAuth2Authenticator) {
+		a.expiryDelta = delta
+	}
+}
+
+// NewOAuth2Authenticator returns a new OAuth2 client credentials authenticator.
+func NewOAuth2Authenticator(clientID, clientSecret string, opts ...OAuth2Option) *OAuth2Authenticator {
+	a := &OAuth2Authenticator{
+		clientID:     clientID,
+		clientSecret: clientSecret,
+		expiryDelta:  defaultOAuth2ExpiryDelta,
+		now:          time.Now,
+		tokens:       make(map[string]*OAuth2Token),
+		refreshing:   make(map[string]chan struct{}),
+	}
+	for _, opt := range opts {
+		opt(a)
+	}
+	return a
+}
+
+func (a *OAuth2Authenticator) Authenticate(req *http.Request) error {
+	return a.authenticate(req, defaultBaseURL, defaultHTTPClient)
+}
+
+// authenticateClient authenticates a request of c, taking the defaults of the
+// token endpoint and the HTTP client from c.
+func (a *OAuth2Authenticator) authenticateClient(c *Client, req *http.Request) error {
+	return a.authenticate(req, c.baseURL.String(), c.httpClient)
+}
+
+func (a *OAuth2Authenticator) authenticate(req *http.Request, baseURL string, httpClient *http.Client) error {
+	token, err := a.token(req.Context(), baseURL, httpClient)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Authorization", "Bearer "+token.AccessToken)
+	return nil
+}
+
+// Token returns the cached access token, requesting a new one if it is
+// missing or about to expire.
+func (a *OAuth2Authenticator) Token(ctx context.Context) (*OAuth2Token, error) {
+	return a.token(ctx, defaultBaseURL, defaultHTTPClient)
+}
+
+// token returns the token of the endpoint set by WithOAuth2TokenURL, or else of
+// baseURL. The lock is not held during the token request; concurrent callers
+// wait for the request in flight instead of sending their own.
+func (a *OAuth2Authenticator) token(ctx context.Context, baseURL string, httpClient *http.Client) (*OAuth2Token, error) {
+	tokenURL := a.tokenURL
+	if tokenURL == "" {
+		tokenURL = baseURL + "tokens/request_token"
+	}
+	if a.httpClient != nil {
+		httpClient = a.httpClient
+	}
+
+	for {
+		a.mu.Lock()
+		if token := a.tokens[tokenURL]; token != nil && a.now().Add(a.expiryDelta).Before(token.expiry) {
+			a.mu.Unlock()
+			return token, nil
+		}
+		if refreshing, ok := a.refreshing[tokenURL]; ok {
+			a.mu.Unlock()
+			select {
+			case <-refreshing:
+				continue
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+		}
+		refreshing := make(chan struct{})
+		a.refreshing[tokenURL] = refreshing
+		a.mu.Unlock()
+
+		token, err := a.requestToken(ctx, tokenURL, httpClient)
+
+		a.mu.Lock()
+		if err == nil {
+			a.tokens[tokenURL] = token
+		}
+		delete(a.refreshing, tokenURL)
+		a.mu.Unlock()
+		close(refreshing)
+
+		return token, err
+	}
+}
+
+func (a *OAuth2Authenticator) requestToken(
+	ctx context.Context, tokenURL string, httpClient *http.Client,
+) (*OAuth2Token, error) {
+	form := url.Values{"grant_type": {"client_credentials"}}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	req.SetBasicAuth(a.clientID, a.clientSecret)
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close() // nolint:errcheck
+
+	var rawBody RawBody
+	if err := json.NewDecoder(resp.Body).Decode(&rawBody); err != nil {
+		return nil, &ErrorResponse{
+			response: resp,
+			err:      fmt.Errorf("decode token response body: %w", err),
+		}
+	}
+	if rawBody.Status != 1 {
+		return nil, &ErrorResponse{
+			response: resp,
+			rawBody:  &rawBody,
+			err:      errors.New(rawBody.Info),
+		}
+	}
+
+	token := new(OAuth2Token)
+	if err := json.Unmarshal(rawBody.Data, token); err != nil {
+		return nil, err
+	}
+	if token.AccessToken == "" {
+		return nil, errors.New("tapd: token response without access_token")
+	}
+	token.expiry = a.now().Add(time.Duration(token.ExpiresIn) * time.Second)
+
+	return token, nil
+}
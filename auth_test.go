@@ -0,0 +1,205 @@
+package tapd
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuth_BearerAuthClient(t *testing.T) {
+	srv, _ := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer personal-token", r.Header.Get("Authorization"))
+
+		fmt.Fprint(w, successResponse) // nolint:errcheck
+	}))
+
+	client, err := NewBearerAuthClient("personal-token", WithBaseURL(srv.URL))
+	require.NoError(t, err)
+
+	req, err := client.NewRequest(ctx, http.MethodGet, "__/bearer-auth", nil, nil)
+	require.NoError(t, err)
+
+	_, err = client.Do(req, nil)
+	assert.NoError(t, err)
+}
+
+func TestAuth_WithAuthenticator(t *testing.T) {
+	srv, _ := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _, ok := r.BasicAuth()
+		assert.False(t, ok)
+		assert.Equal(t, "Bearer custom-token", r.Header.Get("Authorization"))
+
+		fmt.Fprint(w, successResponse) // nolint:errcheck
+	}))
+
+	// the last authentication option wins
+	client, err := newClient(
+		WithBaseURL(srv.URL),
+		WithBasicAuth(apiClientID, apiClientSecret),
+		WithAuthenticator(NewBearerAuthenticator("custom-token")),
+	)
+	require.NoError(t, err)
+
+	req, err := client.NewRequest(ctx, http.MethodGet, "__/custom-auth", nil, nil)
+	require.NoError(t, err)
+
+	_, err = client.Do(req, nil)
+	assert.NoError(t, err)
+}
+
+func TestAuth_OAuth2Client(t *testing.T) {
+	var tokenRequests atomic.Int32
+	srv, _ := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/tokens/request_token":
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.NoError(t, r.ParseForm())
+			assert.Equal(t, "client_credentials", r.PostForm.Get("grant_type"))
+
+			clientID, clientSecret, ok := r.BasicAuth()
+			assert.True(t, ok)
+			assert.Equal(t, apiClientID, clientID)
+			assert.Equal(t, apiClientSecret, clientSecret)
+
+			n := tokenRequests.Add(1)
+			fmt.Fprintf(w, `{
+  "status": 1,
+  "data": {
+    "access_token": "access-token-%d",
+    "expires_in": 7200,
+    "token_type": "Bearer",
+    "scope": ""
+  },
+  "info": "success"
+}`, n) // nolint:errcheck
+		default:
+			assert.Equal(t, fmt.Sprintf("Bearer access-token-%d", tokenRequests.Load()), r.Header.Get("Authorization"))
+			fmt.Fprint(w, successResponse) // nolint:errcheck
+		}
+	}))
+
+	client, err := NewOAuth2Client(apiClientID, apiClientSecret, WithBaseURL(srv.URL))
+	require.NoError(t, err)
+
+	authenticator, ok := client.authenticator.(*OAuth2Authenticator)
+	require.True(t, ok)
+	now := time.Now()
+	authenticator.now = func() time.Time { return now }
+
+	doRequest := func() {
+		req, err := client.NewRequest(ctx, http.MethodGet, "__/oauth2", nil, nil)
+		require.NoError(t, err)
+		_, err = client.Do(req, nil)
+		require.NoError(t, err)
+	}
+
+	// the token is cached
+	doRequest()
+	doRequest()
+	assert.Equal(t, int32(1), tokenRequests.Load())
+
+	// and refreshed shortly before it expires
+	now = now.Add(7200*time.Second - defaultOAuth2ExpiryDelta + time.Second)
+	doRequest()
+	assert.Equal(t, int32(2), tokenRequests.Load())
+
+	token, err := authenticator.token(ctx, client.baseURL.String(), client.httpClient)
+	require.NoError(t, err)
+	assert.Equal(t, "access-token-2", token.AccessToken)
+	assert.Equal(t, 7200, token.ExpiresIn)
+	assert.Equal(t, "Bearer", token.TokenType)
+}
+
+func TestAuth_OAuth2Error(t *testing.T) {
+	srv, _ := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/oauth/token", r.URL.Path)
+
+		w.WriteHeader(http.StatusUnauthorized)
+		fmt.Fprint(w, `{"status": 0, "data": {}, "info": "invalid client"}`) // nolint:errcheck
+	}))
+
+	client, err := newClient(
+		WithBaseURL(srv.URL),
+		WithOAuth2(apiClientID, apiClientSecret, WithOAuth2TokenURL(srv.URL+"/oauth/token")),
+	)
+	require.NoError(t, err)
+
+	_, err = client.NewRequest(ctx, http.MethodGet, "__/oauth2", nil, nil)
+	assert.ErrorIs(t, err, ErrUnauthorized)
+	assert.True(t, IsErrorResponse(err))
+}
+
+func TestAuth_OAuth2Shared(t *testing.T) {
+	newServer := func(name string) *httptest.Server {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/tokens/request_token":
+				fmt.Fprintf(w, `{"status": 1, "data": {"access_token": "%s", "expires_in": 7200}, "info": "success"}`, name) // nolint:errcheck,lll
+			default:
+				assert.Equal(t, "Bearer "+name, r.Header.Get("Authorization"))
+				fmt.Fprint(w, successResponse) // nolint:errcheck
+			}
+		}))
+		t.Cleanup(srv.Close)
+		return srv
+	}
+
+	authenticator := NewOAuth2Authenticator(apiClientID, apiClientSecret)
+	for _, name := range []string{"first", "second"} {
+		srv := newServer(name)
+		client, err := newClient(WithBaseURL(srv.URL), WithAuthenticator(authenticator))
+		require.NoError(t, err)
+
+		// every client requests its token from its own base URL
+		req, err := client.NewRequest(ctx, http.MethodGet, "__/oauth2", nil, nil)
+		require.NoError(t, err)
+		_, err = client.Do(req, nil)
+		assert.NoError(t, err)
+	}
+
+	// the authenticator is left untouched
+	assert.Empty(t, authenticator.tokenURL)
+	assert.Nil(t, authenticator.httpClient)
+}
+
+func TestAuth_OAuth2TokenNotLocked(t *testing.T) {
+	release := make(chan struct{})
+	var tokenRequests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tokenRequests.Add(1)
+		<-release
+		fmt.Fprint(w, `{"status": 1, "data": {"access_token": "access-token", "expires_in": 7200}, "info": "success"}`) // nolint:errcheck,lll
+	}))
+	t.Cleanup(srv.Close)
+
+	authenticator := NewOAuth2Authenticator(apiClientID, apiClientSecret,
+		WithOAuth2TokenURL(srv.URL+"/tokens/request_token"))
+
+	done := make(chan error)
+	go func() {
+		_, err := authenticator.Token(ctx)
+		done <- err
+	}()
+	require.Eventually(t, func() bool { return tokenRequests.Load() == 1 }, time.Second, time.Millisecond)
+
+	// a caller waiting for the token request in flight gives up with its context
+	waitCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	_, err := authenticator.Token(waitCtx)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	close(release)
+	require.NoError(t, <-done)
+
+	token, err := authenticator.Token(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "access-token", token.AccessToken)
+	assert.Equal(t, int32(1), tokenRequests.Load())
+}
@@ -5,17 +5,27 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
+	"maps"
+	"mime/multipart"
 	"net/http"
 	"net/url"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/google/go-querystring/query"
+	"github.com/hashicorp/go-retryablehttp"
 )
 
 const (
 	defaultBaseURL   = "https://api.tapd.cn/"
 	defaultUserAgent = "go-tapd"
+
+	defaultThrottleRetryMax     = 3
+	defaultThrottleRetryWaitMin = 1 * time.Second
+	defaultThrottleRetryWaitMax = 30 * time.Second
 )
 
 var defaultHTTPClient = NewRetryableHTTPClient()
@@ -24,8 +34,8 @@ type Client struct {
 	// baseURL for API requests.
 	baseURL *url.URL
 
-	// clientID, clientSecret for basic authentication.
-	clientID, clientSecret string
+	// authenticator adds the credentials to every request.
+	authenticator Authenticator
 
 	// userAgent used for HTTP requests
 	userAgent string
@@ -33,6 +43,14 @@ type Client struct {
 	// httpClient is the HTTP client used to communicate with the API.
 	httpClient *http.Client
 
+	// rateLimiter limits the request rate of all services, nil means unlimited.
+	rateLimiter *rateLimiter
+
+	// throttleRetryMax, throttleRetryWaitMin, throttleRetryWaitMax control
+	// the retries of requests throttled by TAPD.
+	throttleRetryMax                           int
+	throttleRetryWaitMin, throttleRetryWaitMax time.Duration
+
 	// services used for talking to different parts of the Tapd API.
 	StoryService      *StoryService
 	BugService        *BugService
@@ -48,6 +66,14 @@ type Client struct {
 	UserService       *UserService
 	WorkflowService   *WorkflowService
 	SettingService    *SettingService
+	TestCaseService   *TestCaseService
+	TestPlanService   *TestPlanService
+	ReleaseService    *ReleaseService
+	WikiService       *WikiService
+	BoardService      *BoardService
+	SourceCodeService *SourceCodeService
+	StorageService    *StorageService
+	LiteService       *LiteService
 }
 
 // NewClient returns a new Tapd API client.
@@ -62,11 +88,28 @@ func NewBasicAuthClient(clientID, clientSecret string, opts ...ClientOption) (*C
 		WithBasicAuth(clientID, clientSecret))...)
 }
 
+// NewBearerAuthClient returns a new Tapd API client authenticated with a
+// bearer token, such as a personal access token.
+func NewBearerAuthClient(token string, opts ...ClientOption) (*Client, error) {
+	return newClient(append(opts,
+		WithBearerToken(token))...)
+}
+
+// NewOAuth2Client returns a new Tapd API client authenticated with access
+// tokens of an OAuth application, refreshed automatically before they expire.
+func NewOAuth2Client(clientID, clientSecret string, opts ...ClientOption) (*Client, error) {
+	return newClient(append(opts,
+		WithOAuth2(clientID, clientSecret))...)
+}
+
 // newClient returns a new Tapd API client.
 func newClient(opts ...ClientOption) (*Client, error) {
 	c := &Client{
-		userAgent:  defaultUserAgent,
-		httpClient: defaultHTTPClient,
+		userAgent:            defaultUserAgent,
+		httpClient:           defaultHTTPClient,
+		throttleRetryMax:     defaultThrottleRetryMax,
+		throttleRetryWaitMin: defaultThrottleRetryWaitMin,
+		throttleRetryWaitMax: defaultThrottleRetryWaitMax,
 	}
 	for _, opt := range opts {
 		if err := opt(c); err != nil {
@@ -94,6 +137,14 @@ func newClient(opts ...ClientOption) (*Client, error) {
 	c.UserService = &UserService{client: c}
 	c.WorkflowService = &WorkflowService{client: c}
 	c.SettingService = &SettingService{client: c}
+	c.TestCaseService = &TestCaseService{client: c}
+	c.TestPlanService = &TestPlanService{client: c}
+	c.ReleaseService = &ReleaseService{client: c}
+	c.WikiService = &WikiService{client: c}
+	c.BoardService = &BoardService{client: c}
+	c.SourceCodeService = &SourceCodeService{client: c}
+	c.StorageService = &StorageService{client: c}
+	c.LiteService = &LiteService{client: c}
 
 	return c, nil
 }
@@ -109,6 +160,18 @@ func (c *Client) setup() error {
 	return nil
 }
 
+// authenticate adds the credentials of the authenticator to the request.
+func (c *Client) authenticate(req *http.Request) error {
+	switch a := c.authenticator.(type) {
+	case nil:
+		return nil
+	case *OAuth2Authenticator:
+		return a.authenticateClient(c, req)
+	default:
+		return a.Authenticate(req)
+	}
+}
+
 // setBaseURL sets the base URL for API requests to a custom endpoint.
 func (c *Client) setBaseURL(urlStr string) error {
 	if !strings.HasSuffix(urlStr, "/") {
@@ -154,7 +217,7 @@ func (c *Client) NewRequest(ctx context.Context, method, path string, data any,
 			if err != nil {
 				return nil, err
 			}
-			body = io.NopCloser(bytes.NewReader(b))
+			body = bytes.NewReader(b)
 		}
 	case data != nil:
 		q, err := query.Values(data)
@@ -169,9 +232,9 @@ func (c *Client) NewRequest(ctx context.Context, method, path string, data any,
 		return nil, err
 	}
 
-	// basic auth
-	if c.clientID != "" && c.clientSecret != "" {
-		req.SetBasicAuth(c.clientID, c.clientSecret)
+	// authentication
+	if err := c.authenticate(req); err != nil {
+		return nil, err
 	}
 
 	// Set the request specific headers.
@@ -189,7 +252,86 @@ func (c *Client) NewRequest(ctx context.Context, method, path string, data any,
 	return req, nil
 }
 
+// NewUploadRequest creates a multipart/form-data POST request that uploads the
+// content of file as the form file field "file". The fields of data are
+// encoded like query parameters and sent as form fields.
+func (c *Client) NewUploadRequest(ctx context.Context, path string, data any, filename string, file io.Reader, opts []RequestOption) (*http.Request, error) { //nolint:lll
+	var buf bytes.Buffer
+	w := multipart.NewWriter(&buf)
+
+	if data != nil {
+		fields, err := query.Values(data)
+		if err != nil {
+			return nil, err
+		}
+		for _, key := range slices.Sorted(maps.Keys(fields)) {
+			for _, value := range fields[key] {
+				if err := w.WriteField(key, value); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	part, err := w.CreateFormFile("file", filename)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := io.Copy(part, file); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	req, err := c.NewRequest(ctx, http.MethodPost, path, nil, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	body := buf.Bytes()
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	req.ContentLength = int64(len(body))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(body)), nil
+	}
+	req.Header.Set("Content-Type", w.FormDataContentType())
+
+	return req, nil
+}
+
+// Do sends an API request and decodes the data of the response into v.
+// Requests throttled by TAPD are retried with backoff.
 func (c *Client) Do(req *http.Request, v any) (*Response, error) {
+	for attempt := 0; ; attempt++ {
+		resp, err := c.do(req, v)
+
+		var errResp *ErrorResponse
+		if err == nil || attempt >= c.throttleRetryMax || !errors.As(err, &errResp) || !errResp.isThrottled() {
+			return resp, err
+		}
+
+		wait := retryablehttp.DefaultBackoff(c.throttleRetryWaitMin, c.throttleRetryWaitMax, attempt, errResp.response)
+		if err := sleep(req.Context(), wait); err != nil {
+			return nil, err
+		}
+
+		// rewind the request body
+		if req.GetBody != nil {
+			if req.Body, err = req.GetBody(); err != nil {
+				return nil, err
+			}
+		}
+	}
+}
+
+func (c *Client) do(req *http.Request, v any) (*Response, error) {
+	if c.rateLimiter != nil {
+		if err := c.rateLimiter.Wait(req.Context()); err != nil {
+			return nil, err
+		}
+	}
+
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
 		return nil, err
@@ -200,7 +342,10 @@ func (c *Client) Do(req *http.Request, v any) (*Response, error) {
 	// decode response body
 	var rawBody RawBody
 	if err := json.NewDecoder(resp.Body).Decode(&rawBody); err != nil {
-		return nil, err
+		return nil, &ErrorResponse{
+			response: resp,
+			err:      fmt.Errorf("decode response body: %w", err),
+		}
 	}
 
 	// debug mode
@@ -225,3 +370,16 @@ func (c *Client) Do(req *http.Request, v any) (*Response, error) {
 
 	return newResponse(resp), err
 }
+
+// sleep waits for the duration or until the context is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -1,6 +1,10 @@
 package tapd
 
-import "net/http"
+import (
+	"errors"
+	"net/http"
+	"time"
+)
 
 type ClientOption func(*Client) error
 
@@ -13,9 +17,25 @@ func WithBaseURL(urlStr string) ClientOption {
 
 // WithBasicAuth sets the clientID and clientSecret for the client
 func WithBasicAuth(clientID, clientSecret string) ClientOption {
+	return WithAuthenticator(NewBasicAuthenticator(clientID, clientSecret))
+}
+
+// WithBearerToken authenticates the client with a bearer token, such as a personal access token
+func WithBearerToken(token string) ClientOption {
+	return WithAuthenticator(NewBearerAuthenticator(token))
+}
+
+// WithOAuth2 authenticates the client with access tokens requested by the
+// client credentials grant. Tokens are requested from the client's base URL
+// with the client's HTTP client, unless the options say otherwise.
+func WithOAuth2(clientID, clientSecret string, opts ...OAuth2Option) ClientOption {
+	return WithAuthenticator(NewOAuth2Authenticator(clientID, clientSecret, opts...))
+}
+
+// WithAuthenticator sets the authenticator for the client
+func WithAuthenticator(authenticator Authenticator) ClientOption {
 	return func(c *Client) error {
-		c.clientID = clientID
-		c.clientSecret = clientSecret
+		c.authenticator = authenticator
 		return nil
 	}
 }
@@ -33,3 +53,27 @@ func WithHTTPClient(httpClient *http.Client) ClientOption {
 		return nil
 	}
 }
+
+// WithRateLimit limits the client to requestsPerSecond requests per second,
+// with bursts of up to burst requests. The limit is shared by all services.
+func WithRateLimit(requestsPerSecond float64, burst int) ClientOption {
+	return func(c *Client) error {
+		if requestsPerSecond <= 0 {
+			return errors.New("tapd: rate limit must be positive")
+		}
+		c.rateLimiter = newRateLimiter(requestsPerSecond, burst)
+		return nil
+	}
+}
+
+// WithThrottleRetry sets how often and how long requests throttled by TAPD are
+// retried. The wait grows exponentially from waitMin to waitMax, or follows the
+// Retry-After header when present. A retryMax of 0 disables the retries.
+func WithThrottleRetry(retryMax int, waitMin, waitMax time.Duration) ClientOption {
+	return func(c *Client) error {
+		c.throttleRetryMax = retryMax
+		c.throttleRetryWaitMin = waitMin
+		c.throttleRetryWaitMax = waitMax
+		return nil
+	}
+}
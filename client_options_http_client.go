@@ -1,6 +1,7 @@
 package tapd
 
 import (
+	"context"
 	"net/http"
 	"time"
 
@@ -48,8 +49,21 @@ func WithRetryableHTTPClientBackoff(backoff retryablehttp.Backoff) RetryableHTTP
 func NewRetryableHTTPClient(opts ...RetryableHTTPClientOption) *http.Client {
 	retryClient := retryablehttp.NewClient()
 	retryClient.Logger = nil
+	// return the last response once the retries are used up, so that Client.Do
+	// can turn it into an ErrorResponse
+	retryClient.ErrorHandler = retryablehttp.PassthroughErrorHandler
+	retryClient.CheckRetry = checkRetry
 	for _, opt := range opts {
 		opt(retryClient)
 	}
 	return retryClient.StandardClient()
 }
+
+// checkRetry is the default retry policy without HTTP 429, which is left to the
+// throttle retries of Client.Do so that they go through the rate limiter.
+func checkRetry(ctx context.Context, resp *http.Response, err error) (bool, error) {
+	if err == nil && resp != nil && resp.StatusCode == http.StatusTooManyRequests {
+		return false, nil
+	}
+	return retryablehttp.DefaultRetryPolicy(ctx, resp, err)
+}
@@ -7,7 +7,9 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -160,3 +162,39 @@ func TestClient_WithRequestOption(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, http.StatusOK, resp.StatusCode)
 }
+
+func TestClient_InvalidResponse(t *testing.T) {
+	var requests atomic.Int32
+	srv, _ := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Header().Set("Content-Type", "text/html")
+		w.WriteHeader(http.StatusBadGateway)
+		fmt.Fprint(w, "<html><body>502 Bad Gateway</body></html>") // nolint:errcheck
+	}))
+
+	// the default retrying client, with short waits
+	client, err := NewClient(apiClientID, apiClientSecret,
+		WithBaseURL(srv.URL),
+		WithHTTPClient(NewRetryableHTTPClient(
+			WithRetryableHTTPClientRetryMax(2),
+			WithRetryableHTTPClientRetryWaitMin(time.Millisecond),
+			WithRetryableHTTPClientRetryWaitMax(5*time.Millisecond),
+		)),
+	)
+	assert.NoError(t, err)
+
+	req, err := client.NewRequest(ctx, http.MethodGet, "__/invalid-response", nil, nil)
+	assert.NoError(t, err)
+
+	_, err = client.Do(req, nil)
+	assert.True(t, IsErrorResponse(err))
+	assert.ErrorIs(t, err, ErrInvalidResponse)
+	assert.ErrorIs(t, err, ErrServerUnavailable)
+
+	var errResp *ErrorResponse
+	assert.ErrorAs(t, err, &errResp)
+	assert.Equal(t, http.StatusBadGateway, errResp.HTTPStatusCode())
+	assert.Equal(t, http.MethodGet, errResp.Method())
+	assert.Equal(t, srv.URL+"/__/invalid-response", errResp.URL())
+	assert.Equal(t, int32(3), requests.Load())
+}
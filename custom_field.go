@@ -0,0 +1,332 @@
+package tapd
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// 自定义字段的输入类型
+const (
+	CustomFieldTypeText            = "text"             // 单行文本
+	CustomFieldTypeTextarea        = "textarea"         // 多行文本
+	CustomFieldTypeSelect          = "select"           // 下拉单选
+	CustomFieldTypeRadio           = "radio"            // 单选
+	CustomFieldTypeMultiSelect     = "multi_select"     // 下拉多选
+	CustomFieldTypeCheckbox        = "checkbox"         // 多选
+	CustomFieldTypeCascadeCheckbox = "cascade_checkbox" // 级联多选
+	CustomFieldTypeDate            = "dateinput"        // 日期
+	CustomFieldTypeDatetime        = "datetime"         // 日期时间
+	CustomFieldTypeInteger         = "integer"          // 整数
+	CustomFieldTypeFloat           = "float"            // 小数
+	CustomFieldTypeUserChooser     = "user_chooser"     // 人员选择
+)
+
+// CustomFields maps the custom fields of a workspace between their display
+// names (e.g. 客户名称) and the members they are stored in (e.g.
+// custom_field_one). Build one from the settings returned by
+// StoryService.GetStoryCustomFieldsSettings,
+// BugService.GetBugCustomFieldsSettings or
+// TaskService.GetTaskCustomFieldsSettings with NewCustomFields.
+//
+// Values are read by the json tags of the entity, so any struct with the
+// custom_field_* members works, including the webhook events.
+//
+// Example:
+//
+//	settings, _, _ := client.StoryService.GetStoryCustomFieldsSettings(ctx, req)
+//	fields := tapd.NewCustomFields(settings)
+//	tapd.BindCustomFields(fields, stories...)
+//	deadline, err := stories[0].Custom("截止日期").Time()
+type CustomFields struct {
+	byName map[string]*CustomFieldsSetting
+}
+
+// NewCustomFields returns the custom fields described by settings. Disabled
+// fields are ignored.
+func NewCustomFields(settings []*CustomFieldsSetting) *CustomFields {
+	c := &CustomFields{byName: make(map[string]*CustomFieldsSetting, len(settings))}
+	for _, setting := range settings {
+		if setting == nil || setting.Enabled == "0" {
+			continue
+		}
+		if _, ok := c.byName[setting.Name]; ok {
+			continue
+		}
+		c.byName[setting.Name] = setting
+	}
+	return c
+}
+
+// Setting returns the setting of the custom field with the given display name.
+func (c *CustomFields) Setting(name string) (*CustomFieldsSetting, bool) {
+	if c == nil {
+		return nil, false
+	}
+	setting, ok := c.byName[name]
+	return setting, ok
+}
+
+// Get returns the value of the custom field with the given display name in
+// entity, a struct or a pointer to a struct. The value is empty when the field
+// is unknown or entity has no such member.
+func (c *CustomFields) Get(entity any, name string) CustomFieldValue {
+	setting, ok := c.Setting(name)
+	if !ok {
+		return CustomFieldValue{}
+	}
+	raw, _ := jsonFieldString(entity, setting.CustomField)
+	return CustomFieldValue{Setting: setting, Raw: raw}
+}
+
+// Decode copies the custom fields of entity into v, a pointer to a struct whose
+// members are tagged with the display names of the custom fields:
+//
+//	type Order struct {
+//		Customer string    `tapd:"custom=客户名称"`
+//		Deadline time.Time `tapd:"custom=截止日期"`
+//		Budget   float64   `tapd:"custom=预算"`
+//		Regions  []string  `tapd:"custom=区域"`
+//	}
+//
+// Members may be strings, integers, floats, time.Time, *time.Time or
+// []string. Members of unknown custom fields are left untouched.
+func (c *CustomFields) Decode(entity any, v any) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("tapd: Decode requires a non-nil pointer to a struct, got %T", v)
+	}
+	rv = rv.Elem()
+
+	for i := range rv.NumField() {
+		field := rv.Type().Field(i)
+		name, ok := strings.CutPrefix(field.Tag.Get("tapd"), "custom=")
+		if !ok || !field.IsExported() {
+			continue
+		}
+
+		value := c.Get(entity, name)
+		if value.Setting == nil {
+			continue
+		}
+		if err := value.assign(rv.Field(i)); err != nil {
+			return fmt.Errorf("tapd: decode custom field %q into %s: %w", name, field.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// BindCustomFields binds the custom fields to the entities, so that their
+// Custom method can look up values by display name.
+func BindCustomFields[E interface{ bindCustomFields(*CustomFields) }](fields *CustomFields, entities ...E) {
+	for _, entity := range entities {
+		entity.bindCustomFields(fields)
+	}
+}
+
+func (s *Story) bindCustomFields(c *CustomFields) { s.customFields = c }
+func (b *Bug) bindCustomFields(c *CustomFields)   { b.customFields = c }
+func (t *Task) bindCustomFields(c *CustomFields)  { t.customFields = c }
+
+// Custom returns the value of the custom field with the given display name.
+// The custom fields must be bound with BindCustomFields first.
+func (s *Story) Custom(name string) CustomFieldValue { return s.customFields.Get(s, name) }
+
+// Custom returns the value of the custom field with the given display name.
+// The custom fields must be bound with BindCustomFields first.
+func (b *Bug) Custom(name string) CustomFieldValue { return b.customFields.Get(b, name) }
+
+// Custom returns the value of the custom field with the given display name.
+// The custom fields must be bound with BindCustomFields first.
+func (t *Task) Custom(name string) CustomFieldValue { return t.customFields.Get(t, name) }
+
+// CustomFieldValue is the value of a custom field. Setting is nil when the
+// custom field is unknown.
+type CustomFieldValue struct {
+	Setting *CustomFieldsSetting // 自定义字段配置
+	Raw     string               // 原始值
+}
+
+// IsEmpty reports whether the custom field is unknown or has no value.
+func (v CustomFieldValue) IsEmpty() bool {
+	return v.Raw == ""
+}
+
+// String returns the raw value.
+func (v CustomFieldValue) String() string {
+	return v.Raw
+}
+
+// Int returns the value of an integer field. An empty value is 0.
+func (v CustomFieldValue) Int() (int64, error) {
+	if v.IsEmpty() {
+		return 0, nil
+	}
+	return strconv.ParseInt(strings.TrimSpace(v.Raw), 10, 64)
+}
+
+// Float returns the value of a number field. An empty value is 0.
+func (v CustomFieldValue) Float() (float64, error) {
+	if v.IsEmpty() {
+		return 0, nil
+	}
+	return strconv.ParseFloat(strings.TrimSpace(v.Raw), 64)
+}
+
+// Time returns the value of a date or datetime field in the time zone of
+// TAPD. An empty value is the zero time.
+func (v CustomFieldValue) Time() (time.Time, error) {
+	t, err := ParseTime(v.Raw)
+	return t.Time, err
+}
+
+// Strings returns the options of a multi-select field (separated by |) or the
+// users of a user field (separated by ;). An empty value is nil.
+func (v CustomFieldValue) Strings() []string {
+	if v.Setting != nil && strings.Contains(v.Setting.Type, "user") {
+		return ParseUsers(v.Raw)
+	}
+	return ParseLabels(v.Raw)
+}
+
+// Value returns the value converted by the type of the field: time.Time for
+// dates, int64 for integers, float64 for numbers, []string for multi-select
+// and user fields, and string otherwise.
+func (v CustomFieldValue) Value() (any, error) {
+	if v.Setting == nil {
+		return v.Raw, nil
+	}
+
+	switch v.Setting.Type {
+	case CustomFieldTypeDate, CustomFieldTypeDatetime:
+		return v.Time()
+	case CustomFieldTypeInteger:
+		return v.Int()
+	case CustomFieldTypeFloat:
+		return v.Float()
+	case CustomFieldTypeMultiSelect, CustomFieldTypeCheckbox, CustomFieldTypeCascadeCheckbox,
+		CustomFieldTypeUserChooser:
+		return v.Strings(), nil
+	default:
+		return v.Raw, nil
+	}
+}
+
+var (
+	timeType       = reflect.TypeFor[time.Time]()
+	errUnsupported = errors.New("unsupported type")
+)
+
+func (v CustomFieldValue) assign(dst reflect.Value) error {
+	switch {
+	case dst.Type() == timeType:
+		t, err := v.Time()
+		if err != nil {
+			return err
+		}
+		dst.Set(reflect.ValueOf(t))
+		return nil
+	case dst.Kind() == reflect.Pointer && dst.Type().Elem() == timeType:
+		t, err := v.Time()
+		if err != nil {
+			return err
+		}
+		if t.IsZero() {
+			dst.SetZero()
+		} else {
+			dst.Set(reflect.ValueOf(&t))
+		}
+		return nil
+	}
+
+	switch dst.Kind() {
+	case reflect.String:
+		dst.SetString(v.Raw)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := v.Int()
+		if err != nil {
+			return err
+		}
+		dst.SetInt(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := v.Float()
+		if err != nil {
+			return err
+		}
+		dst.SetFloat(f)
+	case reflect.Slice:
+		if dst.Type().Elem().Kind() != reflect.String {
+			return fmt.Errorf("%w %s", errUnsupported, dst.Type())
+		}
+		values := v.Strings()
+		s := reflect.MakeSlice(dst.Type(), len(values), len(values))
+		for i, value := range values {
+			s.Index(i).SetString(value)
+		}
+		dst.Set(s)
+	default:
+		return fmt.Errorf("%w %s", errUnsupported, dst.Type())
+	}
+	return nil
+}
+
+// jsonFieldIndexes caches the struct member indexes by json name per type.
+var jsonFieldIndexes sync.Map // map[reflect.Type]map[string]int
+
+// jsonFieldString returns the string member of entity with the given json name.
+func jsonFieldString(entity any, name string) (string, bool) {
+	rv := reflect.ValueOf(entity)
+	for rv.Kind() == reflect.Pointer || rv.Kind() == reflect.Interface {
+		if rv.IsNil() {
+			return "", false
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return "", false
+	}
+
+	index, ok := jsonFieldIndex(rv.Type())[name]
+	if !ok {
+		return "", false
+	}
+
+	field := rv.Field(index)
+	if field.Kind() == reflect.Pointer {
+		if field.IsNil() {
+			return "", true
+		}
+		field = field.Elem()
+	}
+	if field.Kind() == reflect.String {
+		return field.String(), true
+	}
+	return fmt.Sprint(field.Interface()), true
+}
+
+func jsonFieldIndex(t reflect.Type) map[string]int {
+	if indexes, ok := jsonFieldIndexes.Load(t); ok {
+		return indexes.(map[string]int)
+	}
+
+	indexes := make(map[string]int, t.NumField())
+	for i := range t.NumField() {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "" || name == "-" {
+			continue
+		}
+		indexes[name] = i
+	}
+
+	jsonFieldIndexes.Store(t, indexes)
+	return indexes
+}
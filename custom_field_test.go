@@ -0,0 +1,193 @@
+package tapd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestCustomFields() *CustomFields {
+	return NewCustomFields([]*CustomFieldsSetting{
+		{CustomField: "custom_field_one", Type: CustomFieldTypeText, Name: "客户名称", Enabled: "1"},
+		{CustomField: "custom_field_two", Type: CustomFieldTypeDate, Name: "截止日期", Enabled: "1"},
+		{CustomField: "custom_field_three", Type: CustomFieldTypeFloat, Name: "预算", Enabled: "1"},
+		{CustomField: "custom_field_four", Type: CustomFieldTypeMultiSelect, Name: "区域", Enabled: "1"},
+		{CustomField: "custom_field_five", Type: CustomFieldTypeUserChooser, Name: "验收人", Enabled: "1"},
+		{CustomField: "custom_field_six", Type: CustomFieldTypeInteger, Name: "工时", Enabled: "1"},
+		{CustomField: "custom_field_seven", Type: CustomFieldTypeText, Name: "已停用", Enabled: "0"},
+	})
+}
+
+func newTestCustomFieldsStory() *Story {
+	return &Story{
+		ID:               "1111112222001000001",
+		CustomFieldOne:   "腾讯",
+		CustomFieldTwo:   "2024-08-31",
+		CustomFieldThree: "12.5",
+		CustomFieldFour:  "华东|华南",
+		CustomFieldFive:  "alice;bob;",
+		CustomFieldSix:   "",
+		CustomFieldSeven: "ignored",
+	}
+}
+
+func TestCustomFields_Setting(t *testing.T) {
+	fields := newTestCustomFields()
+
+	setting, ok := fields.Setting("客户名称")
+	require.True(t, ok)
+	assert.Equal(t, "custom_field_one", setting.CustomField)
+
+	_, ok = fields.Setting("已停用")
+	assert.False(t, ok)
+
+	_, ok = fields.Setting("不存在")
+	assert.False(t, ok)
+
+	var nilFields *CustomFields
+	_, ok = nilFields.Setting("客户名称")
+	assert.False(t, ok)
+}
+
+func TestStory_Custom(t *testing.T) {
+	story := newTestCustomFieldsStory()
+
+	// not bound
+	assert.True(t, story.Custom("客户名称").IsEmpty())
+
+	BindCustomFields(newTestCustomFields(), story)
+
+	assert.Equal(t, "腾讯", story.Custom("客户名称").String())
+
+	deadline, err := story.Custom("截止日期").Time()
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 8, 31, 0, 0, 0, 0, Location), deadline)
+
+	budget, err := story.Custom("预算").Float()
+	require.NoError(t, err)
+	assert.Equal(t, 12.5, budget)
+
+	assert.Equal(t, []string{"华东", "华南"}, story.Custom("区域").Strings())
+	assert.Equal(t, []string{"alice", "bob"}, story.Custom("验收人").Strings())
+
+	hours, err := story.Custom("工时").Int()
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), hours)
+
+	unknown := story.Custom("不存在")
+	assert.Nil(t, unknown.Setting)
+	assert.True(t, unknown.IsEmpty())
+}
+
+func TestCustomFieldValue_Value(t *testing.T) {
+	fields := newTestCustomFields()
+	story := newTestCustomFieldsStory()
+
+	value, err := fields.Get(story, "截止日期").Value()
+	require.NoError(t, err)
+	assert.IsType(t, time.Time{}, value)
+
+	value, err = fields.Get(story, "预算").Value()
+	require.NoError(t, err)
+	assert.Equal(t, 12.5, value)
+
+	value, err = fields.Get(story, "区域").Value()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"华东", "华南"}, value)
+
+	value, err = fields.Get(story, "客户名称").Value()
+	require.NoError(t, err)
+	assert.Equal(t, "腾讯", value)
+
+	_, err = CustomFieldValue{Setting: &CustomFieldsSetting{Type: CustomFieldTypeDatetime}, Raw: "bad"}.Value()
+	assert.Error(t, err)
+}
+
+func TestCustomFieldValue_Time(t *testing.T) {
+	for _, raw := range []string{"2024-08-31 10:20:30", "2024-08-31 10:20"} {
+		got, err := CustomFieldValue{Raw: raw}.Time()
+		require.NoError(t, err)
+		assert.Equal(t, 10, got.Hour())
+		assert.Equal(t, 20, got.Minute())
+	}
+
+	got, err := CustomFieldValue{Raw: "0000-00-00 00:00:00"}.Time()
+	require.NoError(t, err)
+	assert.True(t, got.IsZero())
+}
+
+func TestCustomFields_Decode(t *testing.T) {
+	type order struct {
+		ID       string     `json:"id"`
+		Customer string     `tapd:"custom=客户名称"`
+		Deadline time.Time  `tapd:"custom=截止日期"`
+		Accepted *time.Time `tapd:"custom=截止日期"`
+		Budget   float64    `tapd:"custom=预算"`
+		Regions  []string   `tapd:"custom=区域"`
+		Hours    int        `tapd:"custom=工时"`
+		Unknown  string     `tapd:"custom=不存在"`
+	}
+
+	var o order
+	o.Unknown = "keep"
+	require.NoError(t, newTestCustomFields().Decode(newTestCustomFieldsStory(), &o))
+
+	assert.Empty(t, o.ID)
+	assert.Equal(t, "腾讯", o.Customer)
+	assert.Equal(t, time.Date(2024, 8, 31, 0, 0, 0, 0, Location), o.Deadline)
+	require.NotNil(t, o.Accepted)
+	assert.Equal(t, o.Deadline, *o.Accepted)
+	assert.Equal(t, 12.5, o.Budget)
+	assert.Equal(t, []string{"华东", "华南"}, o.Regions)
+	assert.Equal(t, 0, o.Hours)
+	assert.Equal(t, "keep", o.Unknown)
+}
+
+func TestCustomFields_Decode_Errors(t *testing.T) {
+	fields := newTestCustomFields()
+	story := newTestCustomFieldsStory()
+
+	var o struct{}
+	assert.Error(t, fields.Decode(story, o))
+	assert.Error(t, fields.Decode(story, (*struct{})(nil)))
+
+	var bad struct {
+		Budget bool `tapd:"custom=预算"`
+	}
+	assert.Error(t, fields.Decode(story, &bad))
+
+	var invalid struct {
+		Customer int `tapd:"custom=客户名称"`
+	}
+	assert.Error(t, fields.Decode(story, &invalid))
+}
+
+func TestCustomFields_Get_AnyStruct(t *testing.T) {
+	event := struct {
+		CustomFieldOne *string `json:"custom_field_one"`
+		CustomFieldTwo string  `json:"custom_field_two"`
+	}{
+		CustomFieldOne: Ptr("腾讯"),
+	}
+
+	fields := newTestCustomFields()
+	assert.Equal(t, "腾讯", fields.Get(event, "客户名称").String())
+	assert.Equal(t, "腾讯", fields.Get(&event, "客户名称").String())
+	assert.True(t, fields.Get(event, "截止日期").IsEmpty())
+	assert.True(t, fields.Get(event, "预算").IsEmpty())
+	assert.True(t, fields.Get(nil, "客户名称").IsEmpty())
+}
+
+func TestBindCustomFields_BugTask(t *testing.T) {
+	fields := newTestCustomFields()
+
+	bug := &Bug{CustomFieldOne: "腾讯"}
+	task := &Task{CustomFieldOne: "阿里"}
+	BindCustomFields(fields, bug)
+	BindCustomFields(fields, task)
+
+	assert.Equal(t, "腾讯", bug.Custom("客户名称").String())
+	assert.Equal(t, "阿里", task.Custom("客户名称").String())
+}
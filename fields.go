@@ -0,0 +1,172 @@
+package tapd
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// FieldsOf returns the TAPD field names of T for the Fields parameter of list
+// requests. The names are taken from the json tags of the exported fields of
+// T, including the fields of embedded structs; fields without a json tag are
+// skipped.
+//
+// Example:
+//
+//	type StorySlim struct {
+//		ID     string `json:"id"`
+//		Name   string `json:"name"`
+//		Status string `json:"status"`
+//	}
+//
+//	tapd.FieldsOf[StorySlim]() => "id,name,status"
+func FieldsOf[T any]() *Multi[string] {
+	var fields []string
+	appendJSONFields(&fields, reflect.TypeFor[T]())
+	return NewMulti(fields...)
+}
+
+func appendJSONFields(fields *[]string, t reflect.Type) {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return
+	}
+
+	for i := range t.NumField() {
+		field := t.Field(i)
+		tag, hasTag := field.Tag.Lookup("json")
+		name, _, _ := strings.Cut(tag, ",")
+
+		if field.Anonymous && name == "" {
+			appendJSONFields(fields, field.Type)
+			continue
+		}
+		if !field.IsExported() || !hasTag || name == "" || name == "-" {
+			continue
+		}
+		if !slices.Contains(*fields, name) {
+			*fields = append(*fields, name)
+		}
+	}
+}
+
+// GetStoriesAs 获取需求，并解码为调用方定义的结构体 T
+//
+// 未设置 request.Fields 时，只获取 FieldsOf[T] 返回的字段，以减小返回数据量。
+// 可以配合 All 和 CollectAll 使用：
+//
+//	list := func(ctx context.Context, request *tapd.GetStoriesRequest, opts ...tapd.RequestOption) ([]*StorySlim, *tapd.Response, error) {
+//		return tapd.GetStoriesAs[StorySlim](ctx, client.StoryService, request, opts...)
+//	}
+//	stories, err := tapd.CollectAll(ctx, list, request)
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/story/get_stories.html
+func GetStoriesAs[T any](
+	ctx context.Context, s *StoryService, request *GetStoriesRequest, opts ...RequestOption,
+) ([]*T, *Response, error) {
+	r := new(GetStoriesRequest)
+	if request != nil {
+		*r = *request
+	}
+	if r.Fields == nil {
+		r.Fields = FieldsOf[T]()
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "stories", r, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var items []struct {
+		Story *T `json:"Story"`
+	}
+	resp, err := s.client.Do(req, &items)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	stories := make([]*T, 0, len(items))
+	for _, item := range items {
+		stories = append(stories, item.Story)
+	}
+
+	return stories, resp, nil
+}
+
+// GetBugsAs 获取缺陷，并解码为调用方定义的结构体 T
+//
+// 未设置 request.Fields 时，只获取 FieldsOf[T] 返回的字段。
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/bug/get_bugs.html
+func GetBugsAs[T any](
+	ctx context.Context, s *BugService, request *GetBugsRequest, opts ...RequestOption,
+) ([]*T, *Response, error) {
+	r := new(GetBugsRequest)
+	if request != nil {
+		*r = *request
+	}
+	if r.Fields == nil {
+		r.Fields = FieldsOf[T]()
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "bugs", r, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var items []struct {
+		Bug *T `json:"Bug"`
+	}
+	resp, err := s.client.Do(req, &items)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	bugs := make([]*T, 0, len(items))
+	for _, item := range items {
+		bugs = append(bugs, item.Bug)
+	}
+
+	return bugs, resp, nil
+}
+
+// GetTasksAs 获取任务，并解码为调用方定义的结构体 T
+//
+// 未设置 request.Fields 时，只获取 FieldsOf[T] 返回的字段。
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/task/get_tasks.html
+func GetTasksAs[T any](
+	ctx context.Context, s *TaskService, request *GetTasksRequest, opts ...RequestOption,
+) ([]*T, *Response, error) {
+	r := new(GetTasksRequest)
+	if request != nil {
+		*r = *request
+	}
+	if r.Fields == nil {
+		r.Fields = FieldsOf[T]()
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "tasks", r, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var items []struct {
+		Task *T `json:"Task"`
+	}
+	resp, err := s.client.Do(req, &items)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	tasks := make([]*T, 0, len(items))
+	for _, item := range items {
+		tasks = append(tasks, item.Task)
+	}
+
+	return tasks, resp, nil
+}
@@ -0,0 +1,145 @@
+package tapd
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testStorySlim struct {
+	ID      string `json:"id"`
+	Name    string `json:"name,omitempty"`
+	Status  string `json:"status"`
+	Owner   Users  `json:"owner"`
+	Created Time   `json:"created"`
+}
+
+func TestFieldsOf(t *testing.T) {
+	assert.Equal(t, "id,name,status,owner,created", FieldsOf[testStorySlim]().String())
+
+	type base struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	}
+	type withEmbedded struct {
+		base
+		*testStorySlim
+		Ignored  string `json:"-"`
+		NoTag    string
+		Customer string `tapd:"custom=客户名称"`
+		Modified string `json:"modified"`
+	}
+	assert.Equal(t, "id,name,status,owner,created,modified", FieldsOf[withEmbedded]().String())
+	assert.Equal(t, "id,name,status,owner,created", FieldsOf[*testStorySlim]().String())
+	assert.Empty(t, FieldsOf[string]().String())
+}
+
+func TestGetStoriesAs(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/stories", r.URL.Path)
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+		assert.Equal(t, "id,name,status,owner,created", r.URL.Query().Get("fields"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/story/get_stories.json"))
+	}))
+
+	request := &GetStoriesRequest{WorkspaceID: Ptr[int64](11112222)}
+	stories, _, err := GetStoriesAs[testStorySlim](ctx, client.StoryService, request)
+	require.NoError(t, err)
+	require.Len(t, stories, 2)
+	assert.Nil(t, request.Fields, "request must not be modified")
+
+	assert.Equal(t, "1111112222001000101", stories[0].ID)
+	assert.Equal(t, "登录页面支持扫码登录", stories[0].Name)
+	assert.Equal(t, "planning", stories[0].Status)
+	assert.Equal(t, Users{"alice"}, stories[0].Owner)
+	assert.Equal(t, "2024-08-01 09:30:00", stories[0].Created.String())
+	assert.Equal(t, Users{"bob", "carol"}, stories[1].Owner)
+}
+
+func TestGetStoriesAs_Fields(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "id,name", r.URL.Query().Get("fields"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/story/get_stories.json"))
+	}))
+
+	_, _, err := GetStoriesAs[testStorySlim](ctx, client.StoryService, &GetStoriesRequest{
+		WorkspaceID: Ptr[int64](11112222),
+		Fields:      NewMulti("id", "name"),
+	})
+	require.NoError(t, err)
+}
+
+func TestGetStoriesAs_CollectAll(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "id,name,status,owner,created", r.URL.Query().Get("fields"))
+
+		if r.URL.Query().Get("page") != "1" {
+			_, _ = w.Write([]byte(`{"status":1,"data":[],"info":"success"}`))
+			return
+		}
+		_, _ = w.Write(loadData(t, "internal/testdata/api/story/get_stories.json"))
+	}))
+
+	list := func(ctx context.Context, request *GetStoriesRequest, opts ...RequestOption) ([]*testStorySlim, *Response, error) {
+		return GetStoriesAs[testStorySlim](ctx, client.StoryService, request, opts...)
+	}
+	stories, err := CollectAll(ctx, list, &GetStoriesRequest{WorkspaceID: Ptr[int64](11112222)}, WithPagerLimit(2))
+	require.NoError(t, err)
+	assert.Len(t, stories, 2)
+}
+
+func TestGetBugsAs(t *testing.T) {
+	type bugSlim struct {
+		ID     string `json:"id"`
+		Title  string `json:"title"`
+		Status string `json:"status"`
+	}
+
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/bugs", r.URL.Path)
+		assert.Equal(t, "id,title,status", r.URL.Query().Get("fields"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/bug/get_bugs.json"))
+	}))
+
+	bugs, _, err := GetBugsAs[bugSlim](ctx, client.BugService, &GetBugsRequest{
+		WorkspaceID: Ptr(11112222),
+	})
+	require.NoError(t, err)
+	require.Len(t, bugs, 2)
+	assert.Equal(t, "11111222333001000268", bugs[0].ID)
+	assert.Equal(t, "计算不正确", bugs[0].Title)
+	assert.Equal(t, "closed", bugs[0].Status)
+}
+
+func TestGetTasksAs(t *testing.T) {
+	type taskSlim struct {
+		ID      string `json:"id"`
+		Name    string `json:"name"`
+		Owner   Users  `json:"owner"`
+		Created Time   `json:"created"`
+	}
+
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/tasks", r.URL.Path)
+		assert.Equal(t, "id,name,owner,created", r.URL.Query().Get("fields"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/task/get_tasks.json"))
+	}))
+
+	tasks, _, err := GetTasksAs[taskSlim](ctx, client.TaskService, nil)
+	require.NoError(t, err)
+	require.Len(t, tasks, 2)
+	assert.Equal(t, "1111112222001048647", tasks[0].ID)
+	assert.Equal(t, "task name 1", tasks[0].Name)
+	assert.Equal(t, Users{"owner"}, tasks[0].Owner)
+	assert.Equal(t, "2024-01-11 19:12:03", tasks[0].Created.String())
+}
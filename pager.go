@@ -0,0 +1,368 @@
+package tapd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"reflect"
+	"sync"
+)
+
+// defaultPagerLimit is the page size used when neither the request nor the
+// pager options specify one. It matches the TAPD default of 30.
+const defaultPagerLimit = 30
+
+// defaultPagerConcurrency is the number of pages CollectAllParallel fetches at
+// the same time unless WithPagerConcurrency says otherwise.
+const defaultPagerConcurrency = 4
+
+// ListFunc is a list method of a service, e.g. StoryService.GetStories.
+type ListFunc[Req, T any] func(ctx context.Context, request *Req, opts ...RequestOption) ([]*T, *Response, error)
+
+// CountFunc is a count method of a service, e.g. StoryService.GetStoriesCount.
+type CountFunc[Req any] func(ctx context.Context, request *Req, opts ...RequestOption) (int, *Response, error)
+
+type pagerOptions struct {
+	limit       int
+	maxLimit    int
+	concurrency int
+	requestOpts []RequestOption
+	count       func(ctx context.Context, opts ...RequestOption) (int, error)
+	progress    func(fetched, total int)
+}
+
+// PagerOption configures All and CollectAll.
+type PagerOption func(*pagerOptions)
+
+// WithPagerLimit sets the page size.
+func WithPagerLimit(limit int) PagerOption {
+	return func(o *pagerOptions) {
+		o.limit = limit
+	}
+}
+
+// WithPagerMaxLimit sets the page size cap of the endpoint, e.g. 200 for most
+// list endpoints and 100 for change histories. A larger limit is lowered to the
+// cap, as the endpoint would return short pages that end the paging early.
+func WithPagerMaxLimit(maxLimit int) PagerOption {
+	return func(o *pagerOptions) {
+		o.maxLimit = maxLimit
+	}
+}
+
+// WithPagerConcurrency sets the number of pages CollectAllParallel fetches at the same time.
+func WithPagerConcurrency(concurrency int) PagerOption {
+	return func(o *pagerOptions) {
+		o.concurrency = concurrency
+	}
+}
+
+// WithPagerRequestOptions sets the request options applied to every page request.
+func WithPagerRequestOptions(opts ...RequestOption) PagerOption {
+	return func(o *pagerOptions) {
+		o.requestOpts = append(o.requestOpts, opts...)
+	}
+}
+
+// WithPagerCount fetches the total number of items with the matching count
+// endpoint before the first page. The total is used to pre-size CollectAll
+// results, to report progress and to skip the trailing empty page.
+//
+// Example:
+//
+//	tapd.WithPagerCount(client.StoryService.GetStoriesCount, &tapd.GetStoriesCountRequest{
+//		WorkspaceID: tapd.Ptr(11112222),
+//	})
+func WithPagerCount[Req any](count CountFunc[Req], request *Req) PagerOption {
+	return func(o *pagerOptions) {
+		o.count = func(ctx context.Context, opts ...RequestOption) (int, error) {
+			total, _, err := count(ctx, request, opts...)
+			return total, err
+		}
+	}
+}
+
+// WithPagerProgress sets a callback invoked after each page with the number of
+// items fetched so far and the total reported by WithPagerCount (0 if unknown).
+func WithPagerProgress(fn func(fetched, total int)) PagerOption {
+	return func(o *pagerOptions) {
+		o.progress = fn
+	}
+}
+
+// All returns an iterator over every item of a list endpoint. It requests
+// page after page until the total reported by WithPagerCount is reached, or
+// without WithPagerCount until a page shorter than the limit arrives. Use
+// WithPagerMaxLimit when the limit may exceed the page size cap of the
+// endpoint. All stops with ctx.Err() once the context is canceled. The request
+// is copied, so it is never modified.
+//
+// Example:
+//
+//	for story, err := range tapd.All(ctx, client.StoryService.GetStories, &tapd.GetStoriesRequest{
+//		WorkspaceID: tapd.Ptr(11112222),
+//	}) {
+//		if err != nil {
+//			return err
+//		}
+//		log.Println(story.Name)
+//	}
+func All[Req, T any](
+	ctx context.Context, list ListFunc[Req, T], request *Req, opts ...PagerOption,
+) iter.Seq2[*T, error] {
+	return all(ctx, list, request, newPagerOptions(opts...))
+}
+
+func all[Req, T any](
+	ctx context.Context, list ListFunc[Req, T], request *Req, o *pagerOptions,
+) iter.Seq2[*T, error] {
+	return func(yield func(*T, error) bool) {
+		r, err := newPager(request, o)
+		if err != nil {
+			yield(nil, err)
+			return
+		}
+
+		var total int
+		if o.count != nil {
+			if total, err = o.count(ctx, o.requestOpts...); err != nil {
+				yield(nil, err)
+				return
+			}
+		}
+
+		var fetched int
+		for page := r.page; ; page++ {
+			if o.count != nil && fetched >= total {
+				return
+			}
+			if err := ctx.Err(); err != nil {
+				yield(nil, err)
+				return
+			}
+
+			items, _, err := list(ctx, r.request(page), o.requestOpts...)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+
+			fetched += len(items)
+			if o.progress != nil {
+				o.progress(fetched, total)
+			}
+
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			if len(items) == 0 || (o.count == nil && len(items) < r.limit) {
+				return
+			}
+		}
+	}
+}
+
+// CollectAll fetches every item of a list endpoint into a slice. With
+// WithPagerCount the slice is allocated once with the reported total.
+func CollectAll[Req, T any](
+	ctx context.Context, list ListFunc[Req, T], request *Req, opts ...PagerOption,
+) ([]*T, error) {
+	o := newPagerOptions(opts...)
+
+	var total int
+	if o.count != nil {
+		var err error
+		if total, err = o.count(ctx, o.requestOpts...); err != nil {
+			return nil, err
+		}
+
+		// the total is already known, don't count twice
+		o.count = func(context.Context, ...RequestOption) (int, error) {
+			return total, nil
+		}
+	}
+
+	items := make([]*T, 0, total)
+	for item, err := range all(ctx, list, request, o) {
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+// CollectAllParallel fetches every item of a list endpoint into a slice,
+// requesting several pages at the same time. It needs WithPagerCount to know
+// how many pages there are. Items keep the order of a serial fetch, and the
+// first error cancels all outstanding page requests. Fewer items than the
+// total, e.g. because the limit exceeds the page size cap of the endpoint
+// (see WithPagerMaxLimit), is an error.
+//
+// Example:
+//
+//	stories, err := tapd.CollectAllParallel(ctx, client.StoryService.GetStories, &tapd.GetStoriesRequest{
+//		WorkspaceID: tapd.Ptr[int64](11112222),
+//	},
+//		tapd.WithPagerLimit(200),
+//		tapd.WithPagerConcurrency(8),
+//		tapd.WithPagerCount(client.StoryService.GetStoriesCount, &tapd.GetStoriesCountRequest{
+//			WorkspaceID: tapd.Ptr(11112222),
+//		}),
+//	)
+func CollectAllParallel[Req, T any](
+	ctx context.Context, list ListFunc[Req, T], request *Req, opts ...PagerOption,
+) ([]*T, error) {
+	o := newPagerOptions(opts...)
+	if o.count == nil {
+		return nil, errors.New("tapd: CollectAllParallel requires WithPagerCount")
+	}
+
+	r, err := newPager(request, o)
+	if err != nil {
+		return nil, err
+	}
+
+	total, err := o.count(ctx, o.requestOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	remaining := total - (r.page-1)*r.limit
+	if remaining <= 0 {
+		return []*T{}, nil
+	}
+	pages := make([][]*T, (remaining+r.limit-1)/r.limit)
+
+	concurrency := o.concurrency
+	if concurrency <= 0 {
+		concurrency = defaultPagerConcurrency
+	}
+
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		fetched int
+		indexes = make(chan int)
+	)
+	for range min(concurrency, len(pages)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				items, _, err := list(ctx, r.request(r.page+i), o.requestOpts...)
+				if err != nil {
+					cancel(err)
+					return
+				}
+				pages[i] = items
+
+				if o.progress != nil {
+					mu.Lock()
+					fetched += len(items)
+					o.progress(fetched, total)
+					mu.Unlock()
+				}
+			}
+		}()
+	}
+
+dispatch:
+	for i := range pages {
+		select {
+		case indexes <- i:
+		case <-ctx.Done():
+			break dispatch
+		}
+	}
+	close(indexes)
+	wg.Wait()
+
+	if err := context.Cause(ctx); err != nil {
+		return nil, err
+	}
+
+	items := make([]*T, 0, total)
+	for _, page := range pages {
+		items = append(items, page...)
+	}
+
+	if len(items) < remaining {
+		return nil, fmt.Errorf(
+			"tapd: CollectAllParallel fetched %d of %d items, the limit %d may exceed the page size cap of the endpoint",
+			len(items), remaining, r.limit,
+		)
+	}
+
+	return items, nil
+}
+
+// pager holds a private copy of the request and its pagination settings.
+type pager[Req any] struct {
+	base        Req
+	limit, page int
+}
+
+func newPagerOptions(opts ...PagerOption) *pagerOptions {
+	o := &pagerOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+func newPager[Req any](request *Req, o *pagerOptions) (*pager[Req], error) {
+	p := &pager[Req]{limit: defaultPagerLimit, page: 1}
+	if request != nil {
+		p.base = *request
+	}
+
+	limit, page, ok := paginationFields(reflect.ValueOf(&p.base).Elem())
+	if !ok {
+		return nil, fmt.Errorf("tapd: %T does not support pagination", request)
+	}
+
+	if !limit.IsNil() {
+		p.limit = int(limit.Elem().Int())
+	}
+	if o.limit > 0 {
+		p.limit = o.limit
+	}
+	if o.maxLimit > 0 {
+		p.limit = min(p.limit, o.maxLimit)
+	}
+	if !page.IsNil() {
+		p.page = int(page.Elem().Int())
+	}
+
+	return p, nil
+}
+
+// request returns a copy of the request pointing at the given page.
+func (p *pager[Req]) request(page int) *Req {
+	r := p.base
+	limitField, pageField, _ := paginationFields(reflect.ValueOf(&r).Elem())
+	limitField.Set(reflect.ValueOf(Ptr(p.limit)))
+	pageField.Set(reflect.ValueOf(Ptr(page)))
+	return &r
+}
+
+// paginationFields returns the Limit and Page fields of a request struct.
+func paginationFields(v reflect.Value) (limit, page reflect.Value, ok bool) {
+	if v.Kind() != reflect.Struct {
+		return limit, page, false
+	}
+
+	intPtr := reflect.TypeOf((*int)(nil))
+	limit, page = v.FieldByName("Limit"), v.FieldByName("Page")
+	ok = limit.IsValid() && limit.Type() == intPtr && page.IsValid() && page.Type() == intPtr
+	return limit, page, ok
+}
@@ -0,0 +1,306 @@
+package tapd
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// storiesPageHandler serves total stories, paginated by the limit and page query parameters.
+func storiesPageHandler(t *testing.T, total int, requests *atomic.Int32) http.HandlerFunc {
+	return cappedStoriesPageHandler(t, total, 0, requests)
+}
+
+// cappedStoriesPageHandler is storiesPageHandler for an endpoint that serves at
+// most maxLimit stories per page.
+func cappedStoriesPageHandler(t *testing.T, total, maxLimit int, requests *atomic.Int32) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if requests != nil {
+			requests.Add(1)
+		}
+
+		switch r.URL.Path {
+		case "/stories/count":
+			fmt.Fprintf(w, `{"status": 1, "data": {"count": %d}, "info": "success"}`, total) // nolint:errcheck
+		case "/stories":
+			assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+
+			limit, err := strconv.Atoi(r.URL.Query().Get("limit"))
+			require.NoError(t, err)
+			page, err := strconv.Atoi(r.URL.Query().Get("page"))
+			require.NoError(t, err)
+			if maxLimit > 0 {
+				limit = min(limit, maxLimit)
+			}
+
+			items := make([]string, 0, limit)
+			for id := (page-1)*limit + 1; id <= min(page*limit, total); id++ {
+				items = append(items, fmt.Sprintf(`{"Story": {"id": "%d"}}`, id))
+			}
+			fmt.Fprintf(w, `{"status": 1, "data": [%s], "info": "success"}`, strings.Join(items, ",")) // nolint:errcheck
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	}
+}
+
+func TestPager_All(t *testing.T) {
+	var requests atomic.Int32
+	_, client := createServerClient(t, storiesPageHandler(t, 25, &requests))
+
+	request := &GetStoriesRequest{
+		WorkspaceID: Ptr[int64](11112222),
+		Limit:       Ptr(10),
+	}
+
+	ids := make([]string, 0)
+	for story, err := range All(ctx, client.StoryService.GetStories, request) {
+		require.NoError(t, err)
+		ids = append(ids, story.ID)
+	}
+	assert.Len(t, ids, 25)
+	assert.Equal(t, "1", ids[0])
+	assert.Equal(t, "25", ids[24])
+
+	// the short third page is the last one
+	assert.Equal(t, int32(3), requests.Load())
+
+	// the request must not be modified
+	assert.Equal(t, 10, *request.Limit)
+	assert.Nil(t, request.Page)
+}
+
+func TestPager_All_FullLastPage(t *testing.T) {
+	var requests atomic.Int32
+	_, client := createServerClient(t, storiesPageHandler(t, 20, &requests))
+
+	stories, err := CollectAll(ctx, client.StoryService.GetStories, &GetStoriesRequest{
+		WorkspaceID: Ptr[int64](11112222),
+	}, WithPagerLimit(10))
+	require.NoError(t, err)
+	assert.Len(t, stories, 20)
+
+	// two full pages and a trailing empty page
+	assert.Equal(t, int32(3), requests.Load())
+}
+
+func TestPager_All_LimitAboveCap(t *testing.T) {
+	var requests atomic.Int32
+	_, client := createServerClient(t, cappedStoriesPageHandler(t, 25, 10, &requests))
+
+	// the short first page ends the paging
+	stories, err := CollectAll(ctx, client.StoryService.GetStories, &GetStoriesRequest{
+		WorkspaceID: Ptr[int64](11112222),
+	}, WithPagerLimit(20))
+	require.NoError(t, err)
+	assert.Len(t, stories, 10)
+	assert.Equal(t, int32(1), requests.Load())
+
+	// the limit is lowered to the cap
+	requests.Store(0)
+	stories, err = CollectAll(ctx, client.StoryService.GetStories, &GetStoriesRequest{
+		WorkspaceID: Ptr[int64](11112222),
+	}, WithPagerLimit(20), WithPagerMaxLimit(10))
+	require.NoError(t, err)
+	require.Len(t, stories, 25)
+	for i, story := range stories {
+		assert.Equal(t, strconv.Itoa(i+1), story.ID)
+	}
+	assert.Equal(t, int32(3), requests.Load())
+}
+
+func TestPager_All_LimitAboveCap_WithCount(t *testing.T) {
+	var requests atomic.Int32
+	_, client := createServerClient(t, cappedStoriesPageHandler(t, 30, 10, &requests))
+
+	stories, err := CollectAll(ctx, client.StoryService.GetStories, &GetStoriesRequest{
+		WorkspaceID: Ptr[int64](11112222),
+	},
+		WithPagerLimit(20),
+		WithPagerCount(client.StoryService.GetStoriesCount, &GetStoriesCountRequest{
+			WorkspaceID: Ptr(11112222),
+		}),
+	)
+	require.NoError(t, err)
+	assert.Len(t, stories, 30)
+
+	// one count request and three pages
+	assert.Equal(t, int32(4), requests.Load())
+}
+
+func TestPager_All_Break(t *testing.T) {
+	var requests atomic.Int32
+	_, client := createServerClient(t, storiesPageHandler(t, 100, &requests))
+
+	count := 0
+	for _, err := range All(ctx, client.StoryService.GetStories, &GetStoriesRequest{
+		WorkspaceID: Ptr[int64](11112222),
+	}, WithPagerLimit(10)) {
+		require.NoError(t, err)
+		count++
+		if count == 15 {
+			break
+		}
+	}
+	assert.Equal(t, 15, count)
+	assert.Equal(t, int32(2), requests.Load())
+}
+
+func TestPager_All_ContextCanceled(t *testing.T) {
+	_, client := createServerClient(t, storiesPageHandler(t, 100, nil))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var (
+		count   int
+		lastErr error
+	)
+	for _, err := range All(ctx, client.StoryService.GetStories, &GetStoriesRequest{
+		WorkspaceID: Ptr[int64](11112222),
+	}, WithPagerLimit(10)) {
+		if err != nil {
+			lastErr = err
+			break
+		}
+		count++
+		if count == 10 {
+			cancel()
+		}
+	}
+	assert.Equal(t, 10, count)
+	assert.ErrorIs(t, lastErr, context.Canceled)
+}
+
+func TestPager_CollectAll_WithCount(t *testing.T) {
+	var requests atomic.Int32
+	_, client := createServerClient(t, storiesPageHandler(t, 20, &requests))
+
+	progress := make([][2]int, 0)
+	stories, err := CollectAll(ctx, client.StoryService.GetStories, &GetStoriesRequest{
+		WorkspaceID: Ptr[int64](11112222),
+	},
+		WithPagerLimit(10),
+		WithPagerCount(client.StoryService.GetStoriesCount, &GetStoriesCountRequest{
+			WorkspaceID: Ptr(11112222),
+		}),
+		WithPagerProgress(func(fetched, total int) {
+			progress = append(progress, [2]int{fetched, total})
+		}),
+	)
+	require.NoError(t, err)
+	assert.Len(t, stories, 20)
+	assert.Equal(t, 20, cap(stories))
+	assert.Equal(t, [][2]int{{10, 20}, {20, 20}}, progress)
+
+	// one count request and two pages, without the trailing empty page
+	assert.Equal(t, int32(3), requests.Load())
+}
+
+func TestPager_All_Error(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"status": 0, "data": {}, "info": "error"}`) // nolint:errcheck
+	}))
+
+	stories, err := CollectAll(ctx, client.StoryService.GetStories, &GetStoriesRequest{})
+	assert.Error(t, err)
+	assert.True(t, IsErrorResponse(err))
+	assert.Nil(t, stories)
+}
+
+func TestPager_All_Unsupported(t *testing.T) {
+	_, client := createServerClient(t, http.NotFoundHandler())
+
+	_, err := CollectAll(ctx, client.UserService.GetRoles, &GetRolesRequest{})
+	assert.ErrorContains(t, err, "does not support pagination")
+}
+
+func TestPager_CollectAllParallel(t *testing.T) {
+	var requests atomic.Int32
+	_, client := createServerClient(t, storiesPageHandler(t, 95, &requests))
+
+	var fetched atomic.Int32
+	stories, err := CollectAllParallel(ctx, client.StoryService.GetStories, &GetStoriesRequest{
+		WorkspaceID: Ptr[int64](11112222),
+	},
+		WithPagerLimit(10),
+		WithPagerConcurrency(3),
+		WithPagerCount(client.StoryService.GetStoriesCount, &GetStoriesCountRequest{
+			WorkspaceID: Ptr(11112222),
+		}),
+		WithPagerProgress(func(n, total int) {
+			assert.Equal(t, 95, total)
+			fetched.Store(int32(n))
+		}),
+	)
+	require.NoError(t, err)
+	require.Len(t, stories, 95)
+	for i, story := range stories {
+		assert.Equal(t, strconv.Itoa(i+1), story.ID)
+	}
+	assert.Equal(t, int32(95), fetched.Load())
+
+	// one count request and ten pages
+	assert.Equal(t, int32(11), requests.Load())
+}
+
+func TestPager_CollectAllParallel_LimitAboveCap(t *testing.T) {
+	_, client := createServerClient(t, cappedStoriesPageHandler(t, 95, 10, nil))
+
+	stories, err := CollectAllParallel(ctx, client.StoryService.GetStories, &GetStoriesRequest{
+		WorkspaceID: Ptr[int64](11112222),
+	},
+		WithPagerLimit(20),
+		WithPagerCount(client.StoryService.GetStoriesCount, &GetStoriesCountRequest{
+			WorkspaceID: Ptr(11112222),
+		}),
+	)
+	assert.ErrorContains(t, err, "fetched 50 of 95 items")
+	assert.Nil(t, stories)
+
+	stories, err = CollectAllParallel(ctx, client.StoryService.GetStories, &GetStoriesRequest{
+		WorkspaceID: Ptr[int64](11112222),
+	},
+		WithPagerLimit(20),
+		WithPagerMaxLimit(10),
+		WithPagerCount(client.StoryService.GetStoriesCount, &GetStoriesCountRequest{
+			WorkspaceID: Ptr(11112222),
+		}),
+	)
+	require.NoError(t, err)
+	assert.Len(t, stories, 95)
+}
+
+func TestPager_CollectAllParallel_Error(t *testing.T) {
+	pages := storiesPageHandler(t, 95, nil)
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") == "3" {
+			fmt.Fprint(w, `{"status": 0, "data": {}, "info": "error"}`) // nolint:errcheck
+			return
+		}
+		pages(w, r)
+	}))
+
+	stories, err := CollectAllParallel(ctx, client.StoryService.GetStories, &GetStoriesRequest{
+		WorkspaceID: Ptr[int64](11112222),
+	},
+		WithPagerLimit(10),
+		WithPagerCount(client.StoryService.GetStoriesCount, &GetStoriesCountRequest{}),
+	)
+	assert.True(t, IsErrorResponse(err))
+	assert.Nil(t, stories)
+}
+
+func TestPager_CollectAllParallel_WithoutCount(t *testing.T) {
+	_, client := createServerClient(t, http.NotFoundHandler())
+
+	_, err := CollectAllParallel(ctx, client.StoryService.GetStories, &GetStoriesRequest{})
+	assert.ErrorContains(t, err, "requires WithPagerCount")
+}
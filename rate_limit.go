@@ -0,0 +1,102 @@
+package tapd
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// rateLimiter is a token bucket shared by all services of a client.
+type rateLimiter struct {
+	mu     sync.Mutex
+	rate   float64 // tokens per second
+	burst  float64
+	tokens float64
+	last   time.Time
+	now    func() time.Time
+}
+
+func newRateLimiter(rate float64, burst int) *rateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &rateLimiter{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		now:    time.Now,
+	}
+}
+
+// reserve takes a token and returns how long the caller has to wait for it.
+func (l *rateLimiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	if !l.last.IsZero() {
+		l.tokens = min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	}
+	l.last = now
+
+	l.tokens--
+	if l.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-l.tokens / l.rate * float64(time.Second))
+}
+
+// cancel gives back a token taken by reserve.
+func (l *rateLimiter) cancel() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.tokens = min(l.burst, l.tokens+1)
+}
+
+// Wait blocks until a token is available or the context is done.
+func (l *rateLimiter) Wait(ctx context.Context) error {
+	wait := l.reserve()
+	if wait <= 0 {
+		return nil
+	}
+
+	if err := sleep(ctx, wait); err != nil {
+		l.cancel()
+		return err
+	}
+	return nil
+}
+
+// throttleInfoKeywords are fragments of the info messages TAPD answers with
+// when a client exceeds its API quota.
+var throttleInfoKeywords = []string{
+	"too many requests",
+	"rate limit",
+	"频率",
+	"频繁",
+	"限流",
+}
+
+// isThrottled reports whether the error response is a TAPD throttle response.
+func (e *ErrorResponse) isThrottled() bool {
+	if e.response != nil && e.response.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+	if e.rawBody == nil {
+		return false
+	}
+	if e.rawBody.Status == http.StatusTooManyRequests {
+		return true
+	}
+
+	info := strings.ToLower(e.rawBody.Info)
+	for _, keyword := range throttleInfoKeywords {
+		if strings.Contains(info, keyword) {
+			return true
+		}
+	}
+	return false
+}
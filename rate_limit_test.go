@@ -0,0 +1,185 @@
+package tapd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimiter_Reserve(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	limiter := newRateLimiter(2, 2)
+	limiter.now = func() time.Time { return now }
+
+	// burst
+	assert.Equal(t, time.Duration(0), limiter.reserve())
+	assert.Equal(t, time.Duration(0), limiter.reserve())
+
+	// two tokens per second
+	assert.Equal(t, 500*time.Millisecond, limiter.reserve())
+	assert.Equal(t, time.Second, limiter.reserve())
+
+	// refill
+	now = now.Add(2 * time.Second)
+	assert.Equal(t, time.Duration(0), limiter.reserve())
+}
+
+func TestRateLimiter_WaitCanceled(t *testing.T) {
+	limiter := newRateLimiter(0.001, 1)
+	require.NoError(t, limiter.Wait(context.Background()))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, limiter.Wait(ctx), context.DeadlineExceeded)
+
+	// the token of the canceled wait is given back
+	assert.InDelta(t, 0, limiter.tokens, 0.01)
+}
+
+func TestClient_WithRateLimit(t *testing.T) {
+	srv, _ := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, successResponse) // nolint:errcheck
+	}))
+
+	client, err := NewClient(apiClientID, apiClientSecret, WithBaseURL(srv.URL), WithRateLimit(20, 1))
+	require.NoError(t, err)
+
+	start := time.Now()
+	for range 3 {
+		req, err := client.NewRequest(ctx, http.MethodGet, "__/rate-limit", nil, nil)
+		require.NoError(t, err)
+		_, err = client.Do(req, nil)
+		require.NoError(t, err)
+	}
+	assert.GreaterOrEqual(t, time.Since(start), 90*time.Millisecond)
+
+	_, err = NewClient(apiClientID, apiClientSecret, WithRateLimit(0, 1))
+	assert.Error(t, err)
+}
+
+func TestClient_ThrottleRetry(t *testing.T) {
+	tests := []struct {
+		name     string
+		throttle string
+	}{
+		{"info", `{"status": 0, "data": {}, "info": "API访问频率超出限制"}`},
+		{"status", `{"status": 429, "data": {}, "info": "error"}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests atomic.Int32
+			srv, _ := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				// the body must be sent again with every retry
+				var body map[string]string
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				assert.Equal(t, "value", body["key"])
+
+				if requests.Add(1) <= 2 {
+					fmt.Fprint(w, tt.throttle) // nolint:errcheck
+					return
+				}
+				fmt.Fprint(w, successResponse) // nolint:errcheck
+			}))
+
+			client, err := NewClient(apiClientID, apiClientSecret,
+				WithBaseURL(srv.URL),
+				WithThrottleRetry(3, time.Millisecond, 5*time.Millisecond),
+			)
+			require.NoError(t, err)
+
+			req, err := client.NewRequest(ctx, http.MethodPost, "__/throttle", map[string]string{"key": "value"}, nil)
+			require.NoError(t, err)
+
+			_, err = client.Do(req, nil)
+			assert.NoError(t, err)
+			assert.Equal(t, int32(3), requests.Load())
+		})
+	}
+}
+
+func TestClient_ThrottleRetry_HTTPStatus(t *testing.T) {
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) <= 2 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			fmt.Fprint(w, "Too Many Requests") // nolint:errcheck
+			return
+		}
+		fmt.Fprint(w, successResponse) // nolint:errcheck
+	}))
+	t.Cleanup(srv.Close)
+
+	// the default HTTP client
+	client, err := NewClient(apiClientID, apiClientSecret,
+		WithBaseURL(srv.URL),
+		WithThrottleRetry(1, time.Millisecond, 5*time.Millisecond),
+	)
+	require.NoError(t, err)
+
+	req, err := client.NewRequest(ctx, http.MethodGet, "__/throttle", nil, nil)
+	require.NoError(t, err)
+
+	// 429 is not retried by the HTTP client, only by the throttle retries
+	_, err = client.Do(req, nil)
+	assert.ErrorIs(t, err, ErrRateLimited)
+	assert.Equal(t, int32(2), requests.Load())
+
+	req, err = client.NewRequest(ctx, http.MethodGet, "__/throttle", nil, nil)
+	require.NoError(t, err)
+
+	_, err = client.Do(req, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(3), requests.Load())
+}
+
+func TestClient_ThrottleRetryExhausted(t *testing.T) {
+	var requests atomic.Int32
+	srv, _ := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		fmt.Fprint(w, `{"status": 0, "data": {}, "info": "Too Many Requests"}`) // nolint:errcheck
+	}))
+
+	client, err := NewClient(apiClientID, apiClientSecret,
+		WithBaseURL(srv.URL),
+		WithThrottleRetry(2, time.Millisecond, 5*time.Millisecond),
+	)
+	require.NoError(t, err)
+
+	req, err := client.NewRequest(ctx, http.MethodGet, "__/throttle", nil, nil)
+	require.NoError(t, err)
+
+	_, err = client.Do(req, nil)
+	assert.True(t, IsErrorResponse(err))
+	assert.Equal(t, int32(3), requests.Load())
+}
+
+func TestClient_NoRetryOnOtherErrors(t *testing.T) {
+	var requests atomic.Int32
+	srv, _ := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		fmt.Fprint(w, `{"status": 0, "data": {}, "info": "error"}`) // nolint:errcheck
+	}))
+
+	client, err := NewClient(apiClientID, apiClientSecret,
+		WithBaseURL(srv.URL),
+		WithThrottleRetry(3, time.Millisecond, 5*time.Millisecond),
+	)
+	require.NoError(t, err)
+
+	req, err := client.NewRequest(ctx, http.MethodGet, "__/error", nil, nil)
+	require.NoError(t, err)
+
+	_, err = client.Do(req, nil)
+	assert.True(t, IsErrorResponse(err))
+	assert.Equal(t, int32(1), requests.Load())
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"regexp"
 )
 
 // Response represents an API response.
@@ -24,6 +25,17 @@ type RawBody struct {
 	Info   string          `json:"info"`
 }
 
+// Sentinel errors matched by ErrorResponse, use them with errors.Is.
+var (
+	ErrUnauthorized      = errors.New("tapd: unauthorized")       // 认证失败
+	ErrForbidden         = errors.New("tapd: forbidden")          // 无权限
+	ErrNotFound          = errors.New("tapd: not found")          // 资源不存在
+	ErrInvalidRequest    = errors.New("tapd: invalid request")    // 请求参数错误
+	ErrRateLimited       = errors.New("tapd: rate limited")       // 请求频率超出限制
+	ErrInvalidResponse   = errors.New("tapd: invalid response")   // 响应不是合法的 JSON，如网关返回的 HTML 错误页
+	ErrServerUnavailable = errors.New("tapd: server unavailable") // 服务端错误
+)
+
 // ErrorResponse represents a tapd error response.
 type ErrorResponse struct {
 	response *http.Response
@@ -47,6 +59,103 @@ func (e *ErrorResponse) Unwrap() error {
 	return e.err
 }
 
+// Is reports whether the error response matches one of the sentinel errors.
+func (e *ErrorResponse) Is(target error) bool {
+	switch target {
+	case ErrUnauthorized:
+		return e.hasStatus(http.StatusUnauthorized)
+	case ErrForbidden:
+		return e.hasStatus(http.StatusForbidden)
+	case ErrNotFound:
+		return e.hasStatus(http.StatusNotFound)
+	case ErrInvalidRequest:
+		return e.hasStatus(http.StatusBadRequest) || e.hasStatus(http.StatusUnprocessableEntity)
+	case ErrRateLimited:
+		return e.isThrottled()
+	case ErrInvalidResponse:
+		return e.response != nil && e.rawBody == nil
+	case ErrServerUnavailable:
+		return e.HTTPStatusCode() >= http.StatusInternalServerError
+	default:
+		return false
+	}
+}
+
+// hasStatus reports whether either the HTTP status or the TAPD status equals code.
+func (e *ErrorResponse) hasStatus(code int) bool {
+	return e.HTTPStatusCode() == code || e.Status() == code
+}
+
+// Response returns the HTTP response, nil if the request was never answered.
+func (e *ErrorResponse) Response() *http.Response {
+	return e.response
+}
+
+// HTTPStatusCode returns the HTTP status code, 0 if unknown.
+func (e *ErrorResponse) HTTPStatusCode() int {
+	if e.response == nil {
+		return 0
+	}
+	return e.response.StatusCode
+}
+
+// Status returns the TAPD status of the response body, 0 if the body could not be decoded.
+func (e *ErrorResponse) Status() int {
+	if e.rawBody == nil {
+		return 0
+	}
+	return e.rawBody.Status
+}
+
+// Info returns the TAPD info message of the response body.
+func (e *ErrorResponse) Info() string {
+	if e.rawBody == nil {
+		return ""
+	}
+	return e.rawBody.Info
+}
+
+// fieldInfoPatterns match the field name in the info messages of TAPD
+// validation errors, e.g. "workspace_id is required", "invalid field: name"
+// and "参数 owner 不能为空".
+var fieldInfoPatterns = []*regexp.Regexp{
+	regexp.MustCompile(`^\s*([a-z][a-z0-9_]*)\s+(?:is (?:required|invalid|empty|missing|not valid)|must |should |can ?not be|can't be)`),
+	regexp.MustCompile(`(?i:invalid|unknown|missing|required)\s+(?i:field|parameter|param|argument)s?(?:\s*[:：]\s*|\s+)['"]?([a-z][a-z0-9_]*)`),
+	regexp.MustCompile(`(?:参数|字段)\s*['"“「]?([a-z][a-z0-9_]*)`),
+	regexp.MustCompile(`^\s*([a-z][a-z0-9_]*)\s*(?:不能|必须|格式|错误|无效|不合法|不存在|超出)`),
+}
+
+// Field returns the name of the request field a validation error is about, as
+// named in the info message. It reports false for other errors.
+func (e *ErrorResponse) Field() (string, bool) {
+	info := e.Info()
+	if info == "" {
+		return "", false
+	}
+	for _, pattern := range fieldInfoPatterns {
+		if m := pattern.FindStringSubmatch(info); m != nil {
+			return m[1], true
+		}
+	}
+	return "", false
+}
+
+// Method returns the HTTP method of the request.
+func (e *ErrorResponse) Method() string {
+	if e.response == nil || e.response.Request == nil {
+		return ""
+	}
+	return e.response.Request.Method
+}
+
+// URL returns the URL of the request.
+func (e *ErrorResponse) URL() string {
+	if e.response == nil || e.response.Request == nil || e.response.Request.URL == nil {
+		return ""
+	}
+	return e.response.Request.URL.String()
+}
+
 func IsErrorResponse(err error) bool {
 	var e *ErrorResponse
 	return errors.As(err, &e)
@@ -3,9 +3,12 @@ package tapd
 import (
 	"errors"
 	"net/http"
+	"net/url"
+	"slices"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestResponse_ErrorResponse(t *testing.T) {
@@ -31,3 +34,101 @@ func TestResponse_ErrorResponse(t *testing.T) {
 		})
 	}
 }
+
+func TestResponse_ErrorResponse_Is(t *testing.T) {
+	tests := []struct {
+		name string
+		err  *ErrorResponse
+		want []error
+	}{
+		{"http 401", &ErrorResponse{response: &http.Response{StatusCode: 401}}, []error{ErrUnauthorized, ErrInvalidResponse}},                                                //nolint:lll
+		{"status 401", &ErrorResponse{response: &http.Response{StatusCode: 200}, rawBody: &RawBody{Status: 401}}, []error{ErrUnauthorized}},                                  //nolint:lll
+		{"http 403", &ErrorResponse{response: &http.Response{StatusCode: 403}, rawBody: &RawBody{Status: 0}}, []error{ErrForbidden}},                                         //nolint:lll
+		{"status 404", &ErrorResponse{response: &http.Response{StatusCode: 200}, rawBody: &RawBody{Status: 404}}, []error{ErrNotFound}},                                      //nolint:lll
+		{"http 400", &ErrorResponse{response: &http.Response{StatusCode: 400}, rawBody: &RawBody{Status: 0}}, []error{ErrInvalidRequest}},                                    //nolint:lll
+		{"status 422", &ErrorResponse{response: &http.Response{StatusCode: 200}, rawBody: &RawBody{Status: 422}}, []error{ErrInvalidRequest}},                                //nolint:lll
+		{"throttle", &ErrorResponse{response: &http.Response{StatusCode: 200}, rawBody: &RawBody{Status: 0, Info: "API访问频率超出限制"}}, []error{ErrRateLimited}},                  //nolint:lll
+		{"http 502", &ErrorResponse{response: &http.Response{StatusCode: 502}, err: errors.New("invalid character '<'")}, []error{ErrServerUnavailable, ErrInvalidResponse}}, //nolint:lll
+		{"other", &ErrorResponse{response: &http.Response{StatusCode: 200}, rawBody: &RawBody{Status: 0, Info: "error"}}, nil},                                               //nolint:lll
+	}
+
+	sentinels := []error{
+		ErrUnauthorized, ErrForbidden, ErrNotFound, ErrInvalidRequest,
+		ErrRateLimited, ErrInvalidResponse, ErrServerUnavailable,
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, sentinel := range sentinels {
+				assert.Equal(t, slices.Contains(tt.want, sentinel), errors.Is(tt.err, sentinel), sentinel.Error())
+			}
+		})
+	}
+}
+
+func TestResponse_ErrorResponse_Accessors(t *testing.T) {
+	u, err := url.Parse("https://api.tapd.cn/stories?workspace_id=11112222")
+	assert.NoError(t, err)
+
+	e := &ErrorResponse{
+		response: &http.Response{
+			StatusCode: http.StatusOK,
+			Request:    &http.Request{Method: http.MethodGet, URL: u},
+		},
+		rawBody: &RawBody{Status: 0, Info: "error"},
+	}
+	assert.Equal(t, http.StatusOK, e.HTTPStatusCode())
+	assert.Equal(t, 0, e.Status())
+	assert.Equal(t, "error", e.Info())
+	assert.Equal(t, http.MethodGet, e.Method())
+	assert.Equal(t, "https://api.tapd.cn/stories?workspace_id=11112222", e.URL())
+	assert.NotNil(t, e.Response())
+
+	empty := &ErrorResponse{err: errors.New("error")}
+	assert.Equal(t, 0, empty.HTTPStatusCode())
+	assert.Equal(t, 0, empty.Status())
+	assert.Equal(t, "", empty.Info())
+	assert.Equal(t, "", empty.Method())
+	assert.Equal(t, "", empty.URL())
+	assert.Nil(t, empty.Response())
+}
+
+func TestResponse_ErrorResponse_Field(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(loadData(t, "internal/testdata/api/errors/validation_error.json"))
+	}))
+
+	_, _, err := client.StoryService.GetStories(ctx, &GetStoriesRequest{})
+	assert.ErrorIs(t, err, ErrInvalidRequest)
+
+	var errResp *ErrorResponse
+	require.ErrorAs(t, err, &errResp)
+	field, ok := errResp.Field()
+	assert.True(t, ok)
+	assert.Equal(t, "workspace_id", field)
+
+	tests := []struct {
+		info  string
+		field string
+	}{
+		{"name can not be empty", "name"},
+		{"invalid field: custom_field_one", "custom_field_one"},
+		{"Invalid parameter 'iteration_id'", "iteration_id"},
+		{"参数 owner 不能为空", "owner"},
+		{"begin格式错误", "begin"},
+		{"API访问频率超出限制", ""},
+		{"workspace is archived", ""},
+		{"error", ""},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.info, func(t *testing.T) {
+			field, ok := (&ErrorResponse{rawBody: &RawBody{Status: 422, Info: tt.info}}).Field()
+			assert.Equal(t, tt.field != "", ok)
+			assert.Equal(t, tt.field, field)
+		})
+	}
+
+	_, ok = (&ErrorResponse{err: errors.New("error")}).Field()
+	assert.False(t, ok)
+}
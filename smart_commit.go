@@ -0,0 +1,84 @@
+package tapd
+
+import (
+	"regexp"
+	"strings"
+)
+
+// smartCommitPattern matches a single TAPD smart-commit option such as
+// --story=1001234 or --user=alice.
+var smartCommitPattern = regexp.MustCompile(`(?:^|\s)--(story|bug|task|user)=(\S+)`)
+
+// SmartCommitObject is a work item referenced by a smart commit.
+type SmartCommitObject struct {
+	Type EntityType // 业务对象类型
+	ID   string     // 业务对象ID，长ID或短ID
+}
+
+// SmartCommit is the result of parsing the TAPD smart-commit syntax out of a
+// commit message.
+type SmartCommit struct {
+	Objects []*SmartCommitObject // 关联的业务对象，按出现顺序排列
+	User    string               // --user 指定的 TAPD 用户
+	Message string               // 去掉关联语法后的提交说明
+}
+
+// ParseSmartCommit parses the TAPD smart-commit options out of a commit message.
+//
+// The options --story, --bug and --task link the commit to work items; several
+// IDs can be given comma-separated or by repeating the option, and IDs that are
+// not numeric are ignored. --user names the TAPD user the commit belongs to.
+// The remaining text, with the options removed, is returned as Message.
+//
+// Example:
+//
+//	commit := tapd.ParseSmartCommit("--story=1001234 --user=alice fix login redirect")
+//	// commit.Objects: [{story 1001234}], commit.User: "alice", commit.Message: "fix login redirect"
+func ParseSmartCommit(message string) *SmartCommit {
+	commit := new(SmartCommit)
+
+	for _, match := range smartCommitPattern.FindAllStringSubmatch(message, -1) {
+		option, value := match[1], match[2]
+		if option == "user" {
+			commit.User = value
+			continue
+		}
+
+		for _, id := range strings.Split(value, ",") {
+			if !isNumeric(id) {
+				continue
+			}
+			commit.Objects = append(commit.Objects, &SmartCommitObject{
+				Type: EntityType(option),
+				ID:   id,
+			})
+		}
+	}
+
+	commit.Message = strings.TrimSpace(smartCommitPattern.ReplaceAllString(message, ""))
+
+	return commit
+}
+
+// IDs returns the IDs of the referenced work items of the given type.
+func (c *SmartCommit) IDs(entityType EntityType) []string {
+	var ids []string
+	for _, object := range c.Objects {
+		if object.Type == entityType {
+			ids = append(ids, object.ID)
+		}
+	}
+	return ids
+}
+
+func isNumeric(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
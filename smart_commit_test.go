@@ -0,0 +1,76 @@
+package tapd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSmartCommit(t *testing.T) {
+	tests := []struct {
+		name    string
+		message string
+		want    *SmartCommit
+	}{
+		{
+			name:    "story and user",
+			message: "--story=1001234 --user=alice fix login redirect",
+			want: &SmartCommit{
+				Objects: []*SmartCommitObject{{Type: EntityTypeStory, ID: "1001234"}},
+				User:    "alice",
+				Message: "fix login redirect",
+			},
+		},
+		{
+			name:    "options after the message",
+			message: "fix login redirect --bug=1005678 --user=bob",
+			want: &SmartCommit{
+				Objects: []*SmartCommitObject{{Type: EntityTypeBug, ID: "1005678"}},
+				User:    "bob",
+				Message: "fix login redirect",
+			},
+		},
+		{
+			name:    "several work items",
+			message: "--story=1001234,1001235 --task=1000001 --user=alice\n\nrefactor session handling",
+			want: &SmartCommit{
+				Objects: []*SmartCommitObject{
+					{Type: EntityTypeStory, ID: "1001234"},
+					{Type: EntityTypeStory, ID: "1001235"},
+					{Type: EntityTypeTask, ID: "1000001"},
+				},
+				User:    "alice",
+				Message: "refactor session handling",
+			},
+		},
+		{
+			name:    "invalid ids are ignored",
+			message: "--story=abc,1001234 update docs",
+			want: &SmartCommit{
+				Objects: []*SmartCommitObject{{Type: EntityTypeStory, ID: "1001234"}},
+				Message: "update docs",
+			},
+		},
+		{
+			name:    "no options",
+			message: "update --story-id handling",
+			want: &SmartCommit{
+				Message: "update --story-id handling",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ParseSmartCommit(tt.message))
+		})
+	}
+}
+
+func TestSmartCommit_IDs(t *testing.T) {
+	commit := ParseSmartCommit("--story=1001234 --bug=1005678 --story=1001235 --user=alice fix")
+
+	assert.Equal(t, []string{"1001234", "1001235"}, commit.IDs(EntityTypeStory))
+	assert.Equal(t, []string{"1005678"}, commit.IDs(EntityTypeBug))
+	assert.Empty(t, commit.IDs(EntityTypeTask))
+}
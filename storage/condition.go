@@ -0,0 +1,213 @@
+// Package storage builds conditions for the TAPD public storage (公共存储) API.
+//
+// A condition compares fields of the stored data with values and combines the
+// comparisons with AND and OR:
+//
+//	status = "done" AND (priority > 3 OR owner IN ["alice","bob"])
+//
+// Field names are written as is, values are JSON encoded.
+//
+// Example:
+//
+//	cond := storage.Where("status").Eq("done").And(
+//		storage.Where("priority").Gt(3).Or(storage.Where("owner").In("alice", "bob")),
+//	)
+package storage
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// Operator is a comparison operator of the condition syntax.
+type Operator string
+
+const (
+	OpEq      Operator = "="        // 等于
+	OpNe      Operator = "!="       // 不等于
+	OpGt      Operator = ">"        // 大于
+	OpGte     Operator = ">="       // 大于等于
+	OpLt      Operator = "<"        // 小于
+	OpLte     Operator = "<="       // 小于等于
+	OpIn      Operator = "IN"       // 在列表中
+	OpNotIn   Operator = "NOT IN"   // 不在列表中
+	OpLike    Operator = "LIKE"     // 模糊匹配
+	OpIsNull  Operator = "IS NULL"  // 为空
+	OpNotNull Operator = "NOT NULL" // 不为空
+)
+
+const (
+	logicAnd = "AND"
+	logicOr  = "OR"
+)
+
+// Field is a field of the stored data, returned by Where. Call one of its
+// comparison methods to get a Condition.
+type Field struct {
+	name string
+}
+
+// Where starts a condition on the named field.
+func Where(name string) *Field {
+	return &Field{name: name}
+}
+
+// Eq matches data whose field equals value.
+func (f *Field) Eq(value any) *Condition { return f.compare(OpEq, value) }
+
+// Ne matches data whose field does not equal value.
+func (f *Field) Ne(value any) *Condition { return f.compare(OpNe, value) }
+
+// Gt matches data whose field is greater than value.
+func (f *Field) Gt(value any) *Condition { return f.compare(OpGt, value) }
+
+// Gte matches data whose field is greater than or equal to value.
+func (f *Field) Gte(value any) *Condition { return f.compare(OpGte, value) }
+
+// Lt matches data whose field is less than value.
+func (f *Field) Lt(value any) *Condition { return f.compare(OpLt, value) }
+
+// Lte matches data whose field is less than or equal to value.
+func (f *Field) Lte(value any) *Condition { return f.compare(OpLte, value) }
+
+// In matches data whose field is one of values.
+func (f *Field) In(values ...any) *Condition { return f.compare(OpIn, values) }
+
+// NotIn matches data whose field is none of values.
+func (f *Field) NotIn(values ...any) *Condition { return f.compare(OpNotIn, values) }
+
+// Like matches data whose field contains value.
+func (f *Field) Like(value string) *Condition { return f.compare(OpLike, value) }
+
+// IsNull matches data without the field.
+func (f *Field) IsNull() *Condition { return &Condition{field: f.name, op: OpIsNull} }
+
+// NotNull matches data with the field.
+func (f *Field) NotNull() *Condition { return &Condition{field: f.name, op: OpNotNull} }
+
+func (f *Field) compare(op Operator, value any) *Condition {
+	return &Condition{field: f.name, op: op, value: value, hasValue: true}
+}
+
+// Condition is a condition of the public storage API. A Condition is either a
+// single comparison or a group of conditions combined with AND or OR.
+//
+// Conditions are immutable: And and Or return a new Condition.
+type Condition struct {
+	// comparison
+	field    string
+	op       Operator
+	value    any
+	hasValue bool
+
+	// group
+	logic      string
+	conditions []*Condition
+}
+
+// And returns a condition that matches when c and all the others match.
+func (c *Condition) And(others ...*Condition) *Condition {
+	return group(logicAnd, append([]*Condition{c}, others...))
+}
+
+// Or returns a condition that matches when c or any of the others matches.
+func (c *Condition) Or(others ...*Condition) *Condition {
+	return group(logicOr, append([]*Condition{c}, others...))
+}
+
+// And returns a condition that matches when all the conditions match.
+func And(conditions ...*Condition) *Condition {
+	return group(logicAnd, conditions)
+}
+
+// Or returns a condition that matches when any of the conditions matches.
+func Or(conditions ...*Condition) *Condition {
+	return group(logicOr, conditions)
+}
+
+func group(logic string, conditions []*Condition) *Condition {
+	g := &Condition{logic: logic}
+	for _, c := range conditions {
+		switch {
+		case c == nil || c.isEmpty():
+			// skip
+		case c.logic == logic:
+			// (a AND b) AND c is a AND b AND c
+			g.conditions = append(g.conditions, c.conditions...)
+		default:
+			g.conditions = append(g.conditions, c)
+		}
+	}
+
+	if len(g.conditions) == 1 {
+		return g.conditions[0]
+	}
+	return g
+}
+
+func (c *Condition) isEmpty() bool {
+	return c.logic != "" && len(c.conditions) == 0
+}
+
+// String returns the condition in the syntax of the public storage API.
+func (c *Condition) String() string {
+	if c == nil {
+		return ""
+	}
+
+	var b strings.Builder
+	c.write(&b)
+	return b.String()
+}
+
+func (c *Condition) write(b *strings.Builder) {
+	if c.logic == "" {
+		b.WriteString(c.field)
+		b.WriteByte(' ')
+		b.WriteString(string(c.op))
+		if c.hasValue {
+			b.WriteByte(' ')
+			b.WriteString(encodeValue(c.value))
+		}
+		return
+	}
+
+	for i, sub := range c.conditions {
+		if i > 0 {
+			b.WriteByte(' ')
+			b.WriteString(c.logic)
+			b.WriteByte(' ')
+		}
+		if sub.logic != "" {
+			b.WriteByte('(')
+			sub.write(b)
+			b.WriteByte(')')
+		} else {
+			sub.write(b)
+		}
+	}
+}
+
+// EncodeValues implements the query.Encoder interface of go-querystring.
+func (c *Condition) EncodeValues(key string, v *url.Values) error {
+	v.Set(key, c.String())
+	return nil
+}
+
+// MarshalJSON encodes the condition as a JSON string.
+func (c *Condition) MarshalJSON() ([]byte, error) {
+	return json.Marshal(c.String())
+}
+
+func encodeValue(value any) string {
+	var b strings.Builder
+	enc := json.NewEncoder(&b)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(value); err != nil {
+		return strconv.Quote(fmt.Sprint(value))
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
@@ -0,0 +1,93 @@
+package storage
+
+import (
+	"encoding/json"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCondition_String(t *testing.T) {
+	tests := []struct {
+		name string
+		cond *Condition
+		want string
+	}{
+		{"eq string", Where("status").Eq("done"), `status = "done"`},
+		{"eq number", Where("priority").Eq(3), `priority = 3`},
+		{"eq bool", Where("enabled").Eq(true), `enabled = true`},
+		{"ne", Where("status").Ne("done"), `status != "done"`},
+		{"gt", Where("priority").Gt(3), `priority > 3`},
+		{"gte", Where("priority").Gte(3), `priority >= 3`},
+		{"lt", Where("priority").Lt(3), `priority < 3`},
+		{"lte", Where("priority").Lte(3.5), `priority <= 3.5`},
+		{"in", Where("owner").In("alice", "bob"), `owner IN ["alice","bob"]`},
+		{"not in", Where("id").NotIn(1, 2), `id NOT IN [1,2]`},
+		{"like", Where("name").Like("a&b"), `name LIKE "a&b"`},
+		{"is null", Where("owner").IsNull(), `owner IS NULL`},
+		{"not null", Where("owner").NotNull(), `owner NOT NULL`},
+		{"escaped value", Where("name").Eq(`say "hi"`), `name = "say \"hi\""`},
+		{
+			"and",
+			Where("status").Eq("done").And(Where("priority").Gt(3)),
+			`status = "done" AND priority > 3`,
+		},
+		{
+			"or",
+			Where("status").Eq("done").Or(Where("status").Eq("closed")),
+			`status = "done" OR status = "closed"`,
+		},
+		{
+			"nested groups get parentheses",
+			Where("status").Eq("done").And(Where("priority").Gt(3).Or(Where("owner").In("alice"))),
+			`status = "done" AND (priority > 3 OR owner IN ["alice"])`,
+		},
+		{
+			"same logic is flattened",
+			Where("a").Eq(1).And(Where("b").Eq(2)).And(Where("c").Eq(3)),
+			`a = 1 AND b = 2 AND c = 3`,
+		},
+		{
+			"package level and or",
+			Or(And(Where("a").Eq(1), Where("b").Eq(2)), Where("c").Eq(3)),
+			`(a = 1 AND b = 2) OR c = 3`,
+		},
+		{"single condition group", And(Where("a").Eq(1)), `a = 1`},
+		{"nil conditions are skipped", And(nil, Where("a").Eq(1), And()), `a = 1`},
+		{"empty", And(), ``},
+		{"nil", nil, ``},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.cond.String())
+		})
+	}
+}
+
+func TestCondition_Immutable(t *testing.T) {
+	base := Where("a").Eq(1).And(Where("b").Eq(2))
+	_ = base.And(Where("c").Eq(3))
+
+	assert.Equal(t, `a = 1 AND b = 2`, base.String())
+}
+
+func TestCondition_EncodeValues(t *testing.T) {
+	v := url.Values{}
+	require.NoError(t, Where("status").Eq("done").EncodeValues("condition", &v))
+
+	assert.Equal(t, `status = "done"`, v.Get("condition"))
+}
+
+func TestCondition_MarshalJSON(t *testing.T) {
+	data, err := json.Marshal(struct {
+		Condition *Condition `json:"condition"`
+	}{
+		Condition: Where("status").Eq("done"),
+	})
+	require.NoError(t, err)
+
+	assert.JSONEq(t, `{"condition":"status = \"done\""}`, string(data))
+}
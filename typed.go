@@ -0,0 +1,94 @@
+package tapd
+
+import "encoding/json"
+
+// TypedStory is a typed view of the time, number and user fields of a Story.
+// Get one with Story.Typed.
+type TypedStory struct {
+	ID              Int    `json:"id"`
+	ParentID        Int    `json:"parent_id"`        // 父需求ID
+	Created         Time   `json:"created"`          // 创建时间
+	Modified        Time   `json:"modified"`         // 最后修改时间
+	Begin           Time   `json:"begin"`            // 预计开始
+	Due             Time   `json:"due"`              // 预计结束
+	Completed       Time   `json:"completed"`        // 完成时间
+	Effort          Float  `json:"effort"`           // 预估工时
+	EffortCompleted Float  `json:"effort_completed"` // 完成工时
+	Exceed          Float  `json:"exceed"`           // 超出工时
+	Remain          Float  `json:"remain"`           // 剩余工时
+	Progress        Float  `json:"progress"`         // 进度
+	Owner           Users  `json:"owner"`            // 处理人
+	Developer       Users  `json:"developer"`        // 开发人员
+	CC              Users  `json:"cc"`               // 抄送人
+	Label           Labels `json:"label"`            // 标签
+}
+
+// Typed returns the typed view of the story. Empty fields are zero values.
+func (s *Story) Typed() (*TypedStory, error) {
+	return typedView[TypedStory](s)
+}
+
+// TypedBug is a typed view of the time, number and user fields of a Bug.
+// Get one with Bug.Typed.
+type TypedBug struct {
+	ID              Int    `json:"id"`
+	Created         Time   `json:"created"`          // 创建时间
+	Modified        Time   `json:"modified"`         // 最后修改时间
+	Resolved        Time   `json:"resolved"`         // 解决时间
+	Closed          Time   `json:"closed"`           // 关闭时间
+	Begin           Time   `json:"begin"`            // 预计开始
+	Due             Time   `json:"due"`              // 预计结束
+	Effort          Float  `json:"effort"`           // 预估工时
+	EffortCompleted Float  `json:"effort_completed"` // 完成工时
+	Exceed          Float  `json:"exceed"`           // 超出工时
+	Remain          Float  `json:"remain"`           // 剩余工时
+	CurrentOwner    Users  `json:"current_owner"`    // 处理人
+	De              Users  `json:"de"`               // 开发人员
+	Te              Users  `json:"te"`               // 测试人员
+	CC              Users  `json:"cc"`               // 抄送人
+	Label           Labels `json:"label"`            // 标签
+}
+
+// Typed returns the typed view of the bug. Empty fields are zero values.
+func (b *Bug) Typed() (*TypedBug, error) {
+	return typedView[TypedBug](b)
+}
+
+// TypedTask is a typed view of the time, number and user fields of a Task.
+// Get one with Task.Typed.
+type TypedTask struct {
+	ID              Int    `json:"id"`
+	StoryID         Int    `json:"story_id"`         // 关联需求ID
+	Created         Time   `json:"created"`          // 创建时间
+	Modified        Time   `json:"modified"`         // 最后修改时间
+	Begin           Time   `json:"begin"`            // 预计开始
+	Due             Time   `json:"due"`              // 预计结束
+	Completed       Time   `json:"completed"`        // 完成时间
+	Effort          Float  `json:"effort"`           // 预估工时
+	EffortCompleted Float  `json:"effort_completed"` // 完成工时
+	Exceed          Float  `json:"exceed"`           // 超出工时
+	Remain          Float  `json:"remain"`           // 剩余工时
+	Progress        Float  `json:"progress"`         // 进度
+	Owner           Users  `json:"owner"`            // 处理人
+	CC              Users  `json:"cc"`               // 抄送人
+	Label           Labels `json:"label"`            // 标签
+}
+
+// Typed returns the typed view of the task. Empty fields are zero values.
+func (t *Task) Typed() (*TypedTask, error) {
+	return typedView[TypedTask](t)
+}
+
+// typedView converts entity to T through their common json names.
+func typedView[T any](entity any) (*T, error) {
+	data, err := json.Marshal(entity)
+	if err != nil {
+		return nil, err
+	}
+
+	view := new(T)
+	if err := json.Unmarshal(data, view); err != nil {
+		return nil, err
+	}
+	return view, nil
+}
@@ -0,0 +1,84 @@
+package tapd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStory_Typed(t *testing.T) {
+	story := &Story{
+		ID:              "1111112222001000001",
+		ParentID:        "0",
+		Created:         "2024-08-01 09:30:00",
+		Modified:        "2024-08-02 18:00:00",
+		Due:             Ptr("2024-08-31"),
+		Effort:          Ptr("3.5"),
+		EffortCompleted: "1",
+		Remain:          "",
+		Progress:        "40",
+		Owner:           "alice;bob;",
+		Label:           "重要|紧急",
+	}
+
+	typed, err := story.Typed()
+	require.NoError(t, err)
+	assert.Equal(t, Int(1111112222001000001), typed.ID)
+	assert.Equal(t, Int(0), typed.ParentID)
+	assert.Equal(t, time.Date(2024, 8, 1, 9, 30, 0, 0, Location), typed.Created.Time)
+	assert.Equal(t, time.Date(2024, 8, 31, 0, 0, 0, 0, Location), typed.Due.Time)
+	assert.True(t, typed.Begin.IsZero())
+	assert.True(t, typed.Completed.IsZero())
+	assert.Equal(t, Float(3.5), typed.Effort)
+	assert.Equal(t, Float(1), typed.EffortCompleted)
+	assert.Equal(t, Float(0), typed.Remain)
+	assert.Equal(t, Float(40), typed.Progress)
+	assert.Equal(t, Users{"alice", "bob"}, typed.Owner)
+	assert.Nil(t, typed.Developer)
+	assert.Equal(t, Labels{"重要", "紧急"}, typed.Label)
+
+	story.Effort = Ptr("unknown")
+	_, err = story.Typed()
+	assert.Error(t, err)
+}
+
+func TestBug_Typed(t *testing.T) {
+	bug := &Bug{
+		ID:           "1111112222001000002",
+		Created:      "2024-08-01 09:30:00",
+		Resolved:     "0000-00-00 00:00:00",
+		CurrentOwner: "alice;",
+		Te:           "bob;carol;",
+		Effort:       "2",
+	}
+
+	typed, err := bug.Typed()
+	require.NoError(t, err)
+	assert.Equal(t, Int(1111112222001000002), typed.ID)
+	assert.Equal(t, "2024-08-01 09:30:00", typed.Created.String())
+	assert.True(t, typed.Resolved.IsZero())
+	assert.Equal(t, Users{"alice"}, typed.CurrentOwner)
+	assert.Equal(t, Users{"bob", "carol"}, typed.Te)
+	assert.Equal(t, Float(2), typed.Effort)
+}
+
+func TestTask_Typed(t *testing.T) {
+	task := &Task{
+		ID:       "1111112222001000003",
+		StoryID:  "1111112222001000001",
+		Modified: "2024-08-02 18:00:00",
+		Progress: "100",
+		Owner:    "alice;",
+		Label:    "后端",
+	}
+
+	typed, err := task.Typed()
+	require.NoError(t, err)
+	assert.Equal(t, Int(1111112222001000001), typed.StoryID)
+	assert.Equal(t, "2024-08-02 18:00:00", typed.Modified.String())
+	assert.Equal(t, Float(100), typed.Progress)
+	assert.Equal(t, Users{"alice"}, typed.Owner)
+	assert.Equal(t, Labels{"后端"}, typed.Label)
+}
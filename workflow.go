@@ -0,0 +1,75 @@
+package tapd
+
+import "slices"
+
+// Workflow answers questions about the status transitions of a workflow
+// locally, e.g. to validate a status change before calling
+// StoryService.UpdateStory. Get one with WorkflowService.GetWorkflow or build
+// one from transitions with NewWorkflow.
+type Workflow struct {
+	next        map[string][]string
+	transitions map[workflowStep]*WorkflowTransition
+}
+
+type workflowStep struct {
+	from, to string
+}
+
+// NewWorkflow returns a workflow with the given transition rules.
+func NewWorkflow(transitions []*WorkflowTransition) *Workflow {
+	w := &Workflow{
+		next:        make(map[string][]string),
+		transitions: make(map[workflowStep]*WorkflowTransition, len(transitions)),
+	}
+
+	for _, t := range transitions {
+		if t == nil {
+			continue
+		}
+
+		step := workflowStep{from: t.StepPrevious, to: t.StepNext}
+		if _, ok := w.transitions[step]; ok {
+			continue
+		}
+		w.transitions[step] = t
+		w.next[t.StepPrevious] = append(w.next[t.StepPrevious], t.StepNext)
+	}
+
+	return w
+}
+
+// CanTransition reports whether the workflow allows moving from status from
+// to status to. Statuses are the aliases (English names), e.g. planning.
+func (w *Workflow) CanTransition(from, to string) bool {
+	_, ok := w.transitions[workflowStep{from: from, to: to}]
+	return ok
+}
+
+// NextStatuses returns the statuses reachable from status from in a single
+// transition, in the order of the transition rules.
+func (w *Workflow) NextStatuses(from string) []string {
+	return slices.Clone(w.next[from])
+}
+
+// Transition returns the transition rule from status from to status to.
+func (w *Workflow) Transition(from, to string) (*WorkflowTransition, bool) {
+	t, ok := w.transitions[workflowStep{from: from, to: to}]
+	return t, ok
+}
+
+// RequiredFields returns the names of the fields that must be filled in when
+// moving from status from to status to.
+func (w *Workflow) RequiredFields(from, to string) []string {
+	t, ok := w.Transition(from, to)
+	if !ok {
+		return nil
+	}
+
+	var fields []string
+	for _, field := range t.AppendFields {
+		if field.Notnull == "1" {
+			fields = append(fields, field.FieldName)
+		}
+	}
+	return fields
+}
@@ -0,0 +1,69 @@
+package tapd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestWorkflow() *Workflow {
+	return NewWorkflow([]*WorkflowTransition{
+		{
+			StepPrevious: "planning",
+			StepNext:     "developing",
+			AppendFields: []*WorkflowTransitionField{
+				{FieldName: "owner", Notnull: "1"},
+				{FieldName: "iteration_id", Notnull: "0"},
+			},
+		},
+		{StepPrevious: "planning", StepNext: "rejected"},
+		{StepPrevious: "developing", StepNext: "testing"},
+		{StepPrevious: "developing", StepNext: "planning"},
+		{StepPrevious: "testing", StepNext: "resolved"},
+		{StepPrevious: "planning", StepNext: "developing"}, // duplicate
+		nil,
+	})
+}
+
+func TestWorkflow_CanTransition(t *testing.T) {
+	w := newTestWorkflow()
+
+	assert.True(t, w.CanTransition("planning", "developing"))
+	assert.True(t, w.CanTransition("developing", "planning"))
+	assert.False(t, w.CanTransition("planning", "resolved"))
+	assert.False(t, w.CanTransition("resolved", "planning"))
+	assert.False(t, w.CanTransition("planning", "planning"))
+	assert.False(t, w.CanTransition("unknown", "developing"))
+}
+
+func TestWorkflow_NextStatuses(t *testing.T) {
+	w := newTestWorkflow()
+
+	assert.Equal(t, []string{"developing", "rejected"}, w.NextStatuses("planning"))
+	assert.Equal(t, []string{"testing", "planning"}, w.NextStatuses("developing"))
+	assert.Empty(t, w.NextStatuses("resolved"))
+
+	// the result is a copy
+	next := w.NextStatuses("planning")
+	next[0] = "changed"
+	assert.Equal(t, []string{"developing", "rejected"}, w.NextStatuses("planning"))
+}
+
+func TestWorkflow_Transition(t *testing.T) {
+	w := newTestWorkflow()
+
+	transition, ok := w.Transition("planning", "developing")
+	assert.True(t, ok)
+	assert.Len(t, transition.AppendFields, 2)
+
+	_, ok = w.Transition("planning", "resolved")
+	assert.False(t, ok)
+}
+
+func TestWorkflow_RequiredFields(t *testing.T) {
+	w := newTestWorkflow()
+
+	assert.Equal(t, []string{"owner"}, w.RequiredFields("planning", "developing"))
+	assert.Empty(t, w.RequiredFields("developing", "testing"))
+	assert.Nil(t, w.RequiredFields("planning", "resolved"))
+}